@@ -0,0 +1,59 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/validation"
+)
+
+// runBulk memvalidasi satu file CSV atau NDJSON dengan validation.Bulk, report per baris ditulis ke stdout
+// dan ringkasannya ke stderr, format input default dari ekstensi file (stdin: csv)
+func runBulk(typeName string, files []string, format string, options validation.BulkOptions, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(files) > 1 {
+		fmt.Fprintf(stderr, "govalidate: -output %s accepts one input file, got %d\n", options.Report, len(files))
+		return exitError
+	}
+
+	name, reader := files[0], stdin
+	if name != "-" {
+		file, err := os.Open(name)
+		if err != nil {
+			fmt.Fprintln(stderr, "govalidate:", err)
+			return exitError
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	if format == "" {
+		format = validation.BulkCSV
+		if detected, ok := extensionFormats[strings.ToLower(filepath.Ext(name))]; ok {
+			format = detected
+		}
+	}
+	if format != validation.BulkCSV && format != validation.BulkNDJSON {
+		fmt.Fprintf(stderr, "govalidate: -output %s needs csv or ndjson input, got %s\n", options.Report, format)
+		return exitError
+	}
+	options.Input = format
+
+	row, _ := model.New(typeName)
+	summary, err := validation.Default().Bulk(context.Background(), reader, stdout, row, options)
+	if err != nil {
+		fmt.Fprintln(stderr, "govalidate:", err)
+		return exitError
+	}
+
+	fmt.Fprintf(stderr, "%s: %d rows, %d invalid, %d errors\n", typeName, summary.Rows, summary.Invalid, summary.Errors)
+	if summary.Invalid > 0 {
+		return exitInvalid
+	}
+
+	return exitValid
+}
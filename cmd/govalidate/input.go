@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/validation"
+
+	"gopkg.in/yaml.v3"
+)
+
+// format input berdasarkan ekstensi file
+var extensionFormats = map[string]string{
+	".json":   "json",
+	".yaml":   "yaml",
+	".yml":    "yaml",
+	".ndjson": "ndjson",
+	".jsonl":  "ndjson",
+	".csv":    "csv",
+}
+
+// result adalah hasil validasi satu dokumen, Index adalah urutan dokumen (atau nomor baris untuk NDJSON) dimulai dari 1
+type result struct {
+	Source string                  `json:"source"`
+	Index  int                     `json:"index"`
+	Error  string                  `json:"error,omitempty"`
+	Errors []validation.FieldError `json:"errors,omitempty"`
+}
+
+func (r result) valid() bool {
+	return r.Error == "" && len(r.Errors) == 0
+}
+
+// checker membaca dokumen lalu memvalidasinya sebagai tipe typeName
+type checker struct {
+	validate *validation.Validator
+	typeName string
+	locale   string
+	strict   bool
+}
+
+// checkFile membaca satu file (atau stdin untuk -) dan memvalidasi setiap dokumen di dalamnya
+// error hanya dikembalikan untuk kesalahan IO, dokumen yang tidak bisa di decode menjadi result dengan Error
+func (c *checker) checkFile(name, format string, stdin io.Reader) ([]result, error) {
+	source := name
+	reader := stdin
+	if name == "-" {
+		source = "stdin"
+	} else {
+		file, err := os.Open(name)
+		if err != nil {
+			return nil, err
+		}
+		defer file.Close()
+		reader = file
+	}
+
+	if format == "" {
+		format = "json"
+		if detected, ok := extensionFormats[strings.ToLower(filepath.Ext(name))]; ok {
+			format = detected
+		}
+	}
+
+	var documents []document
+	var err error
+	switch format {
+	case "json":
+		documents, err = readJSON(reader)
+	case "yaml":
+		documents, err = readYAML(reader)
+	case "ndjson":
+		documents, err = readNDJSON(reader)
+	case "csv":
+		return nil, fmt.Errorf("%s: csv input needs -output csv or ndjson", source)
+	default:
+		return nil, fmt.Errorf("unknown format %q, available: json, yaml, ndjson", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", source, err)
+	}
+
+	results := make([]result, 0, len(documents))
+	for _, doc := range documents {
+		results = append(results, c.check(source, doc))
+	}
+
+	return results, nil
+}
+
+// document adalah satu dokumen JSON beserta urutannya, err diisi jika dokumen tidak bisa dibaca
+type document struct {
+	index int
+	data  []byte
+	err   error
+}
+
+// check decode dokumen ke tipe typeName lalu menjalankan validate.TransformStruct, sama dengan validation.Bulk
+// yang dipakai -output csv dan ndjson, sehingga tag mod berlaku untuk seluruh format output
+func (c *checker) check(source string, doc document) result {
+	r := result{Source: source, Index: doc.index}
+	if doc.err != nil {
+		r.Error = doc.err.Error()
+		return r
+	}
+
+	value, _ := model.New(c.typeName)
+	decoder := json.NewDecoder(bytes.NewReader(doc.data))
+	if c.strict {
+		decoder.DisallowUnknownFields()
+	}
+	if err := decoder.Decode(value); err != nil {
+		r.Error = err.Error()
+		return r
+	}
+
+	err := c.validate.TransformStruct(value)
+	if err == nil {
+		return r
+	}
+
+	r.Errors = c.validate.FieldErrors(err, c.locale)
+	if r.Errors == nil {
+		r.Error = err.Error()
+	}
+
+	return r
+}
+
+// readJSON membaca satu atau beberapa value JSON, array di level paling luar berarti satu dokumen per element
+func readJSON(reader io.Reader) ([]document, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	data = bytes.TrimSpace(data)
+	if bytes.HasPrefix(data, []byte("[")) {
+		var elements []json.RawMessage
+		if err := json.Unmarshal(data, &elements); err != nil {
+			return []document{{index: 1, err: err}}, nil
+		}
+
+		documents := make([]document, len(elements))
+		for i, element := range elements {
+			documents[i] = document{index: i + 1, data: element}
+		}
+		return documents, nil
+	}
+
+	var documents []document
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	for index := 1; ; index++ {
+		var raw json.RawMessage
+		err := decoder.Decode(&raw)
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			// setelah syntax error posisi decoder tidak bisa dipercaya, dokumen berikutnya tidak dibaca
+			return append(documents, document{index: index, err: err}), nil
+		}
+		documents = append(documents, document{index: index, data: raw})
+	}
+}
+
+// readYAML membaca seluruh dokumen YAML (dipisah ---) dan mengubahnya menjadi JSON agar tag json tetap dipakai
+func readYAML(reader io.Reader) ([]document, error) {
+	var documents []document
+	decoder := yaml.NewDecoder(reader)
+	for index := 1; ; index++ {
+		var value any
+		err := decoder.Decode(&value)
+		if errors.Is(err, io.EOF) {
+			return documents, nil
+		}
+		if err != nil {
+			return append(documents, document{index: index, err: err}), nil
+		}
+
+		data, err := json.Marshal(value)
+		documents = append(documents, document{index: index, data: data, err: err})
+	}
+}
+
+// readNDJSON membaca satu dokumen JSON per baris, baris kosong dilewati, index adalah nomor baris
+func readNDJSON(reader io.Reader) ([]document, error) {
+	var documents []document
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		doc := document{index: line, data: bytes.Clone(data)}
+		if !json.Valid(data) {
+			doc.err = fmt.Errorf("line %d is not valid JSON", line)
+		}
+		documents = append(documents, doc)
+	}
+
+	return documents, scanner.Err()
+}
@@ -0,0 +1,99 @@
+// govalidate memvalidasi file JSON, YAML atau NDJSON terhadap struct yang terdaftar di model,
+// menggunakan validator yang sama dengan service (termasuk custom validation), tanpa perlu menulis kode go
+//
+//	govalidate -type User fixtures/user.json fixtures/users.ndjson
+//	cat user.yaml | govalidate -type User -format yaml -output junit
+//	govalidate -type Address -output csv addresses.csv > report.csv
+//
+// -output csv dan ndjson menulis satu baris report untuk setiap error (row, column, tag, message) secara streaming,
+// input CSV atau NDJSON dibaca satu baris per satu sehingga file import yang sangat besar tetap bisa divalidasi
+//
+// exit code 0 jika semua dokumen valid, 1 jika ada dokumen yang tidak valid dan 2 jika terjadi kesalahan penggunaan atau IO
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/validation"
+)
+
+// exit code govalidate
+const (
+	exitValid   = 0
+	exitInvalid = 1
+	exitError   = 2
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run menjalankan govalidate dan mengembalikan exit code, dipisah dari main agar bisa di test
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("govalidate", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	typeName := flags.String("type", "", "nama tipe yang divalidasi: "+strings.Join(model.TypeNames(), ", "))
+	format := flags.String("format", "", "format input: json, yaml, ndjson atau csv, default dari ekstensi file (stdin: json)")
+	output := flags.String("output", "text", "format report: text, json, junit, atau csv dan ndjson untuk report per baris")
+	locale := flags.String("locale", validation.LocaleEN, "bahasa pesan error: en atau id")
+	strict := flags.Bool("strict", false, "field yang tidak dikenal dianggap error")
+	if err := flags.Parse(args); err != nil {
+		return exitError
+	}
+
+	if _, ok := model.New(*typeName); !ok {
+		fmt.Fprintf(stderr, "govalidate: unknown type %q, available: %s\n", *typeName, strings.Join(model.TypeNames(), ", "))
+		return exitError
+	}
+
+	files := flags.Args()
+	if len(files) == 0 {
+		files = []string{"-"}
+	}
+
+	if *output == validation.BulkCSV || *output == validation.BulkNDJSON {
+		options := validation.BulkOptions{Report: *output, Locale: *locale, Strict: *strict}
+		return runBulk(*typeName, files, *format, options, stdin, stdout, stderr)
+	}
+
+	write, ok := reporters[*output]
+	if !ok {
+		fmt.Fprintf(stderr, "govalidate: unknown output %q, available: text, json, junit, csv, ndjson\n", *output)
+		return exitError
+	}
+
+	checker := &checker{
+		validate: validation.Default(),
+		typeName: *typeName,
+		locale:   *locale,
+		strict:   *strict,
+	}
+
+	var results []result
+	for _, name := range files {
+		fileResults, err := checker.checkFile(name, *format, stdin)
+		if err != nil {
+			fmt.Fprintln(stderr, "govalidate:", err)
+			return exitError
+		}
+		results = append(results, fileResults...)
+	}
+
+	if err := write(stdout, *typeName, results); err != nil {
+		fmt.Fprintln(stderr, "govalidate:", err)
+		return exitError
+	}
+
+	for _, r := range results {
+		if !r.valid() {
+			return exitInvalid
+		}
+	}
+
+	return exitValid
+}
@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const validLogin = `{"username":"akuutauf@email.com","password":"rahasia"}`
+
+func TestRunText(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "login.ndjson")
+	content := validLogin + "\n\n" + `{"username":"akuutauf","password":"123"}` + "\n" + "{bukan json\n"
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-type", "LoginRequest", path}, nil, &stdout, &stderr)
+	if code != exitInvalid {
+		t.Fatalf("expected exit %d, got %d: %s", exitInvalid, code, stderr.String())
+	}
+
+	output := stdout.String()
+	for _, expected := range []string{"ok   " + path + "#1", "FAIL " + path + "#3", "username: username must be a valid email address", "FAIL " + path + "#4", "3 documents, 2 invalid"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected output to contain %q:\n%s", expected, output)
+		}
+	}
+}
+
+func TestRunYAMLStdinJSONReport(t *testing.T) {
+	input := "username: akuutauf@email.com\npassword: rahasia\n---\nusername: akuutauf@email.com\n"
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-type", "LoginRequest", "-format", "yaml", "-output", "json", "-locale", "id"}, strings.NewReader(input), &stdout, &stderr)
+	if code != exitInvalid {
+		t.Fatalf("expected exit %d, got %d: %s", exitInvalid, code, stderr.String())
+	}
+
+	var report jsonReport
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatal(err)
+	}
+	if report.Total != 2 || report.Invalid != 1 || report.Documents[0].Source != "stdin" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	if errors := report.Documents[1].Errors; len(errors) != 1 || errors[0].Path != "password" || errors[0].Message != "password wajib diisi" {
+		t.Errorf("unexpected errors: %+v", errors)
+	}
+}
+
+func TestRunJUnit(t *testing.T) {
+	input := "[" + validLogin + `,{"username":"akuutauf@email.com"}]`
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-type", "LoginRequest", "-output", "junit"}, strings.NewReader(input), &stdout, &stderr)
+	if code != exitInvalid {
+		t.Fatalf("expected exit %d, got %d: %s", exitInvalid, code, stderr.String())
+	}
+
+	var suites junitSuites
+	if err := xml.Unmarshal(stdout.Bytes(), &suites); err != nil {
+		t.Fatal(err)
+	}
+	suite := suites.Suites[0]
+	if suite.Tests != 2 || suite.Failures != 1 || suite.Cases[0].Failure != nil || suite.Cases[1].Failure == nil {
+		t.Errorf("unexpected suite: %+v", suite)
+	}
+}
+
+func TestRunExitCodes(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := run([]string{"-type", "LoginRequest"}, strings.NewReader(validLogin), &stdout, &stderr); code != exitValid {
+		t.Errorf("expected exit %d for valid input, got %d: %s", exitValid, code, stderr.String())
+	}
+	if code := run([]string{"-type", "Unknown"}, strings.NewReader(validLogin), &stdout, &stderr); code != exitError {
+		t.Errorf("expected exit %d for unknown type, got %d", exitError, code)
+	}
+	if code := run([]string{"-type", "LoginRequest", "missing.json"}, nil, &stdout, &stderr); code != exitError {
+		t.Errorf("expected exit %d for missing file, got %d", exitError, code)
+	}
+}
+
+func TestRunBulk(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "addresses.csv")
+	if err := os.WriteFile(path, []byte("city,country\nMalang,Indonesia\n,Indonesia\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := run([]string{"-type", "Address", "-output", "ndjson", "-locale", "id", path}, nil, &stdout, &stderr)
+	if code != exitInvalid {
+		t.Fatalf("expected exit %d, got %d: %s", exitInvalid, code, stderr.String())
+	}
+	if expected := `{"row":3,"column":"city","tag":"required","message":"city wajib diisi"}` + "\n"; stdout.String() != expected {
+		t.Errorf("unexpected report %q", stdout.String())
+	}
+	if !strings.Contains(stderr.String(), "Address: 2 rows, 1 invalid, 1 errors") {
+		t.Errorf("unexpected summary %q", stderr.String())
+	}
+
+	stdout.Reset()
+	if code := run([]string{"-type", "Address", "-output", "csv", "-format", "ndjson"}, strings.NewReader(`{"city":"Malang","country":"Indonesia"}`), &stdout, &stderr); code != exitValid {
+		t.Errorf("expected exit %d, got %d: %s", exitValid, code, stderr.String())
+	}
+	if stdout.String() != "row,column,tag,message\n" {
+		t.Errorf("unexpected report %q", stdout.String())
+	}
+
+	if code := run([]string{"-type", "Address", path}, nil, &stdout, &stderr); code != exitError {
+		t.Errorf("expected exit %d for csv input without per-row output, got %d", exitError, code)
+	}
+	if code := run([]string{"-type", "Address", "-output", "csv", path, path}, nil, &stdout, &stderr); code != exitError {
+		t.Errorf("expected exit %d for multiple files, got %d", exitError, code)
+	}
+}
+
+func TestRunTransform(t *testing.T) {
+	// tag mod (trim,lower) dijalankan sebelum validasi, baik untuk laporan per dokumen maupun per baris
+	input := `{"username":" A@B.com ","password":"rahasia"}` + "\n"
+	for _, output := range []string{"text", "json", "junit", "ndjson", "csv"} {
+		var stdout, stderr bytes.Buffer
+		code := run([]string{"-type", "LoginRequest", "-format", "ndjson", "-output", output}, strings.NewReader(input), &stdout, &stderr)
+		if code != exitValid {
+			t.Errorf("%s: expected exit %d, got %d: %s%s", output, exitValid, code, stdout.String(), stderr.String())
+		}
+	}
+}
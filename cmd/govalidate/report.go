@@ -0,0 +1,155 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// reporter menulis hasil validasi seluruh dokumen ke w
+type reporter func(w io.Writer, typeName string, results []result) error
+
+var reporters = map[string]reporter{
+	"text":  writeText,
+	"json":  writeJSON,
+	"junit": writeJUnit,
+}
+
+// name adalah nama dokumen di report, contoh fixtures/users.ndjson#3
+func (r result) name() string {
+	return fmt.Sprintf("%s#%d", r.Source, r.Index)
+}
+
+// messages mengembalikan seluruh pesan error dokumen, satu pesan per baris
+func (r result) messages() []string {
+	if r.Error != "" {
+		return []string{"decode: " + r.Error}
+	}
+
+	messages := make([]string, 0, len(r.Errors))
+	for _, fieldError := range r.Errors {
+		messages = append(messages, fieldError.Path+": "+fieldError.Message)
+	}
+
+	return messages
+}
+
+// summary menghitung jumlah dokumen yang tidak valid
+func summary(results []result) (invalid int) {
+	for _, r := range results {
+		if !r.valid() {
+			invalid++
+		}
+	}
+
+	return invalid
+}
+
+// writeText menulis report untuk dibaca manusia, dokumen valid ditulis ok
+func writeText(w io.Writer, typeName string, results []result) error {
+	for _, r := range results {
+		if r.valid() {
+			if _, err := fmt.Fprintf(w, "ok   %s\n", r.name()); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := fmt.Fprintf(w, "FAIL %s\n", r.name()); err != nil {
+			return err
+		}
+		for _, message := range r.messages() {
+			if _, err := fmt.Fprintf(w, "     %s\n", message); err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err := fmt.Fprintf(w, "%s: %d documents, %d invalid\n", typeName, len(results), summary(results))
+	return err
+}
+
+// jsonReport adalah bentuk report -output json
+type jsonReport struct {
+	Type      string   `json:"type"`
+	Total     int      `json:"total"`
+	Invalid   int      `json:"invalid"`
+	Documents []result `json:"documents"`
+}
+
+// writeJSON menulis report JSON, errors berisi validation.FieldError yang sama dengan response API
+func writeJSON(w io.Writer, typeName string, results []result) error {
+	if results == nil {
+		results = []result{}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(jsonReport{
+		Type:      typeName,
+		Total:     len(results),
+		Invalid:   summary(results),
+		Documents: results,
+	})
+}
+
+// bentuk report JUnit XML, satu testcase untuk setiap dokumen
+type junitSuites struct {
+	XMLName xml.Name     `xml:"testsuites"`
+	Suites  []junitSuite `xml:"testsuite"`
+}
+
+type junitSuite struct {
+	Name     string      `xml:"name,attr"`
+	Tests    int         `xml:"tests,attr"`
+	Failures int         `xml:"failures,attr"`
+	Cases    []junitCase `xml:"testcase"`
+}
+
+type junitCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// writeJUnit menulis report JUnit XML agar bisa dibaca oleh CI, classname adalah nama file
+func writeJUnit(w io.Writer, typeName string, results []result) error {
+	suite := junitSuite{Name: "govalidate " + typeName, Tests: len(results), Failures: summary(results)}
+	for _, r := range results {
+		testCase := junitCase{ClassName: r.Source, Name: r.name()}
+		if !r.valid() {
+			messages := r.messages()
+			failureType := "validation"
+			if r.Error != "" {
+				failureType = "decode"
+			}
+			testCase.Failure = &junitFailure{
+				Message: fmt.Sprintf("%d errors", len(messages)),
+				Type:    failureType,
+				Text:    strings.Join(messages, "\n"),
+			}
+		}
+		suite.Cases = append(suite.Cases, testCase)
+	}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	if err := encoder.Encode(junitSuites{Suites: []junitSuite{suite}}); err != nil {
+		return err
+	}
+
+	_, err := io.WriteString(w, "\n")
+	return err
+}
@@ -0,0 +1,102 @@
+// openapigen menulis openapi.yaml dari handler yang di register di routes, tanpa perlu menjalankan server
+//
+//	go run ./cmd/openapigen -o openapi.yaml
+package main
+
+//go:generate go run . -o ../../openapi.yaml -source ../../model
+
+import (
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/openapi"
+	"belajar-go-lang-validation/problem"
+	"belajar-go-lang-validation/validation"
+)
+
+func main() {
+	output := flag.String("o", "openapi.yaml", "file hasil generate, - untuk stdout")
+	source := flag.String("source", "model", "folder source struct untuk description, dipisah koma")
+	flag.Parse()
+
+	spec, err := newSpec(strings.Split(*source, ",")...)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "openapigen:", err)
+		os.Exit(1)
+	}
+
+	file := os.Stdout
+	if *output != "-" {
+		created, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "openapigen:", err)
+			os.Exit(1)
+		}
+		defer created.Close()
+		file = created
+	}
+
+	if err := spec.WriteYAML(file); err != nil {
+		fmt.Fprintln(os.Stderr, "openapigen:", err)
+		os.Exit(1)
+	}
+}
+
+// newSpec membuat Spec berisi seluruh routes, description diambil dari komentar struct di folder source
+func newSpec(source ...string) (*openapi.Spec, error) {
+	validate := validation.Default()
+	spec := openapi.New(validate, openapi.Info{
+		Title:   "Belajar Go Lang Validation",
+		Version: "1.0.0",
+	})
+	routes(http.NewServeMux(), spec, validate)
+
+	if err := spec.LoadComments(source...); err != nil {
+		return nil, err
+	}
+
+	return spec, nil
+}
+
+// routes mendaftarkan handler service beserta dokumentasinya ke mux, server cukup memanggil fungsi yang sama
+func routes(mux *http.ServeMux, spec *openapi.Spec, validate *validation.Validator) {
+	spec.Handle(mux, "POST /register", problem.Middleware[model.RegisterRequest](validate)(noContent), openapi.Route{
+		OperationID: "register",
+		Summary:     "Registrasi user dengan email dan nomor handphone",
+		Tags:        []string{"auth"},
+		Request:     model.RegisterRequest{},
+	})
+	spec.Handle(mux, "POST /login", problem.Middleware[model.LoginRequest](validate)(noContent), openapi.Route{
+		OperationID: "login",
+		Summary:     "Login menggunakan email dan password",
+		Tags:        []string{"auth"},
+		Request:     model.LoginRequest{},
+	})
+	spec.Handle(mux, "POST /users", problem.Middleware[model.RegisterUser](validate)(statusCreated), openapi.Route{
+		OperationID: "createUser",
+		Summary:     "Registrasi user dengan konfirmasi password",
+		Tags:        []string{"users"},
+		Request:     model.RegisterUser{},
+		Status:      http.StatusCreated,
+	})
+	spec.Handle(mux, "PUT /users/{id}", problem.Middleware[model.User](validate)(noContent), openapi.Route{
+		OperationID: "updateUser",
+		Summary:     "Mengubah data lengkap user",
+		Tags:        []string{"users"},
+		Request:     model.User{},
+	})
+}
+
+// noContent adalah handler contoh, request yang sampai di sini sudah lolos validasi
+var noContent = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusNoContent)
+})
+
+// statusCreated adalah handler contoh untuk route dengan Status http.StatusCreated
+var statusCreated = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusCreated)
+})
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"belajar-go-lang-validation/openapi"
+	"belajar-go-lang-validation/validation"
+)
+
+func TestOpenAPIUpToDate(t *testing.T) {
+	spec, err := newSpec("../../model")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buffer bytes.Buffer
+	if err := spec.WriteYAML(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	committed, err := os.ReadFile("../../openapi.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buffer.Bytes(), committed) {
+		t.Error("openapi.yaml is out of date, run go generate ./cmd/openapigen")
+	}
+}
+
+func TestRoutesWriteDocumentedStatus(t *testing.T) {
+	mux := http.NewServeMux()
+	routes(mux, openapi.New(validation.Default(), openapi.Info{}), validation.Default())
+
+	body := `{"username": "akuutauf@email.com", "password": "Rahasia#2024", "confirm_password": "Rahasia#2024"}`
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/users", strings.NewReader(body)))
+	if recorder.Code != http.StatusCreated {
+		t.Errorf("expected %d, got %d: %s", http.StatusCreated, recorder.Code, recorder.Body.String())
+	}
+}
@@ -0,0 +1,759 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"belajar-go-lang-validation/validation"
+	"belajar-go-lang-validation/validation/generated"
+)
+
+// runtimeImport adalah package yang di import oleh kode hasil generate
+const runtimeImport = "belajar-go-lang-validation/validation/generated"
+
+// tag yang dibaca untuk menentukan nama field, sama dengan TagNameFunc di package validation
+var nameTags = []string{"json", "form", "query"}
+
+type kind int
+
+const (
+	kindOther kind = iota
+	kindString
+	kindBool
+	kindInt
+	kindUint
+	kindFloat
+	kindSlice
+	kindMap
+	kindPointer
+	kindStruct
+)
+
+// fieldType adalah informasi tipe field yang dibutuhkan untuk membuat kode validasi
+type fieldType struct {
+	kind kind
+	// expr adalah tipe go, contoh string atau []Address
+	expr string
+	// name adalah nama struct untuk kindStruct
+	name string
+	elem *fieldType
+	key  *fieldType
+}
+
+// rule adalah satu tag beserta parameternya, contoh min=3
+type rule struct {
+	tag   string
+	param string
+}
+
+// check adalah satu bagian tag yang dipisah koma, lebih dari satu rule berarti OR, contoh email|phone_id
+// alias diisi jika check berasal dari alias, contoh varchar
+type check struct {
+	alias string
+	rules []rule
+}
+
+// chain adalah hasil parsing satu tag validate, dive beserta keys menunjuk ke chain untuk element collection
+type chain struct {
+	omitEmpty bool
+	checks    []check
+	dive      bool
+	keys      *chain
+	elem      *chain
+}
+
+func (c *chain) empty() bool {
+	return c == nil || (!c.omitEmpty && len(c.checks) == 0 && !c.dive)
+}
+
+// field adalah konteks field struct yang sedang dibuatkan kode validasinya
+type field struct {
+	name       string
+	structName string
+	parent     map[string]*fieldType
+}
+
+type generator struct {
+	pkg       string
+	structs   map[string]*ast.StructType
+	named     map[string]ast.Expr
+	validator *validation.Validator
+	queued    map[string]bool
+	queue     []string
+	vars      int
+}
+
+// Generate membaca file go di dir lalu membuat method Validate untuk typeNames dan struct yang dipakai di dalamnya
+// tag yang tidak didukung akan mengembalikan error, sehingga hasil generate tidak pernah berbeda dengan validate.Struct
+func Generate(dir string, typeNames []string, output string) ([]byte, error) {
+	g := &generator{
+		structs:   map[string]*ast.StructType{},
+		named:     map[string]ast.Expr{},
+		validator: validation.Default(),
+		queued:    map[string]bool{},
+	}
+	if err := g.parse(dir, output); err != nil {
+		return nil, err
+	}
+
+	for _, name := range typeNames {
+		name = strings.TrimSpace(name)
+		if _, ok := g.structs[name]; !ok {
+			return nil, fmt.Errorf("struct %s not found in %s", name, dir)
+		}
+		g.enqueue(name)
+	}
+
+	var body bytes.Buffer
+	for len(g.queue) > 0 {
+		name := g.queue[0]
+		g.queue = g.queue[1:]
+		if err := g.generateType(&body, name); err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	var file bytes.Buffer
+	fmt.Fprintf(&file, "// Code generated by validategen. DO NOT EDIT.\n\npackage %s\n\nimport %q\n\n", g.pkg, runtimeImport)
+	file.Write(body.Bytes())
+
+	return format.Source(file.Bytes())
+}
+
+// parse membaca seluruh file go (kecuali test dan file output) dan mengumpulkan deklarasi tipe
+func (g *generator) parse(dir, output string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	fileSet := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == output {
+			continue
+		}
+
+		file, err := parser.ParseFile(fileSet, path, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		if g.pkg != "" && g.pkg != file.Name.Name {
+			return fmt.Errorf("multiple packages in %s: %s and %s", dir, g.pkg, file.Name.Name)
+		}
+		g.pkg = file.Name.Name
+
+		ast.Inspect(file, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+			if structType, ok := spec.Type.(*ast.StructType); ok {
+				g.structs[spec.Name.Name] = structType
+			} else {
+				g.named[spec.Name.Name] = spec.Type
+			}
+			return false
+		})
+	}
+
+	if g.pkg == "" {
+		return fmt.Errorf("no go files in %s", dir)
+	}
+
+	return nil
+}
+
+func (g *generator) enqueue(name string) {
+	if !g.queued[name] {
+		g.queued[name] = true
+		g.queue = append(g.queue, name)
+	}
+}
+
+// newVar membuat nama variable yang unik, contoh path1
+func (g *generator) newVar(prefix string) string {
+	g.vars++
+	return prefix + strconv.Itoa(g.vars)
+}
+
+// generateType membuat method Validate dan validateInto untuk satu struct
+func (g *generator) generateType(w *bytes.Buffer, name string) error {
+	structType := g.structs[name]
+
+	types := map[string]*fieldType{}
+	for _, astField := range structType.Fields.List {
+		typ, err := g.parseType(astField.Type)
+		if err != nil {
+			return err
+		}
+		for _, ident := range astField.Names {
+			types[ident.Name] = typ
+		}
+	}
+
+	var fields bytes.Buffer
+	for _, astField := range structType.Fields.List {
+		if len(astField.Names) == 0 {
+			return fmt.Errorf("embedded fields are not supported")
+		}
+
+		var tag reflect.StructTag
+		if astField.Tag != nil {
+			raw, err := strconv.Unquote(astField.Tag.Value)
+			if err != nil {
+				return err
+			}
+			tag = reflect.StructTag(raw)
+		}
+
+		rawRules := tag.Get(validation.ValidateTagKey)
+		if rawRules == "-" {
+			continue
+		}
+
+		for _, ident := range astField.Names {
+			if !ident.IsExported() {
+				continue
+			}
+
+			issues := g.validator.LintTag(rawRules, func(path string) bool {
+				_, ok := types[path]
+				return ok
+			})
+			if len(issues) > 0 {
+				return fmt.Errorf("field %s: tag %q: %s", ident.Name, rawRules, issues[0].Message)
+			}
+
+			c, err := g.parseChain(rawRules)
+			if err != nil {
+				return fmt.Errorf("field %s: %w", ident.Name, err)
+			}
+
+			current := field{name: wireName(tag, ident.Name), structName: ident.Name, parent: types}
+			pathVar := g.newVar("path")
+
+			var code bytes.Buffer
+			if err := g.value(&code, "x."+ident.Name, types[ident.Name], c, pathVar, false, current); err != nil {
+				return fmt.Errorf("field %s: %w", ident.Name, err)
+			}
+			if code.Len() == 0 {
+				continue
+			}
+
+			fmt.Fprintf(&fields, "{\n%s := path.Field(%q, %q)\n%s}\n", pathVar, current.name, current.structName, code.String())
+		}
+	}
+
+	fmt.Fprintf(w, "// Validate memvalidasi %s tanpa reflection, hasilnya sama dengan validate.Struct\n", name)
+	fmt.Fprintf(w, "func (x %s) Validate() error {\nvar errs generated.Errors\nroot := generated.Root(%q)\nx.validateInto(&root, &errs)\nreturn errs.Err()\n}\n\n", name, name)
+	fmt.Fprintf(w, "func (x *%s) validateInto(path *generated.Path, errs *generated.Errors) {\n%s", name, fields.String())
+	fmt.Fprintf(w, "if fn := generated.LookupStructLevel(%q); fn != nil {\nerrs.Report(path, fn(*x))\n}\n}\n\n", g.pkg+"."+name)
+
+	return nil
+}
+
+// wireName mengambil nama field dari tag json lalu form lalu query, sama dengan TagNameFunc di package validation
+func wireName(tag reflect.StructTag, structName string) string {
+	for _, key := range nameTags {
+		name := strings.SplitN(tag.Get(key), ",", 2)[0]
+		if name == "-" {
+			return structName
+		}
+		if name != "" {
+			return name
+		}
+	}
+
+	return structName
+}
+
+var basicKinds = map[string]kind{
+	"string": kindString,
+	"bool":   kindBool,
+	"int":    kindInt, "int8": kindInt, "int16": kindInt, "int32": kindInt, "int64": kindInt, "rune": kindInt,
+	"uint": kindUint, "uint8": kindUint, "uint16": kindUint, "uint32": kindUint, "uint64": kindUint, "byte": kindUint, "uintptr": kindUint,
+	"float32": kindFloat, "float64": kindFloat,
+}
+
+// parseType mengubah ast tipe field menjadi fieldType, tipe yang tidak dikenal menjadi kindOther
+func (g *generator) parseType(expr ast.Expr) (*fieldType, error) {
+	var b bytes.Buffer
+	if err := format.Node(&b, token.NewFileSet(), expr); err != nil {
+		return nil, err
+	}
+	typ := &fieldType{expr: b.String()}
+
+	switch expr := expr.(type) {
+	case *ast.Ident:
+		if k, ok := basicKinds[expr.Name]; ok {
+			typ.kind = k
+			return typ, nil
+		}
+		if _, ok := g.structs[expr.Name]; ok {
+			typ.kind = kindStruct
+			typ.name = expr.Name
+			return typ, nil
+		}
+		if underlying, ok := g.named[expr.Name]; ok {
+			resolved, err := g.parseType(underlying)
+			if err != nil {
+				return nil, err
+			}
+			resolved.expr = typ.expr
+			return resolved, nil
+		}
+	case *ast.StarExpr:
+		elem, err := g.parseType(expr.X)
+		if err != nil {
+			return nil, err
+		}
+		typ.kind = kindPointer
+		typ.elem = elem
+	case *ast.ArrayType:
+		if expr.Len != nil {
+			return typ, nil
+		}
+		elem, err := g.parseType(expr.Elt)
+		if err != nil {
+			return nil, err
+		}
+		typ.kind = kindSlice
+		typ.elem = elem
+	case *ast.MapType:
+		key, err := g.parseType(expr.Key)
+		if err != nil {
+			return nil, err
+		}
+		elem, err := g.parseType(expr.Value)
+		if err != nil {
+			return nil, err
+		}
+		typ.kind = kindMap
+		typ.key = key
+		typ.elem = elem
+	}
+
+	return typ, nil
+}
+
+// parseChain memecah tag validate menjadi chain, mengikuti aturan parsing validator package
+func (g *generator) parseChain(raw string) (*chain, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	return g.parseParts(strings.Split(raw, ","), "")
+}
+
+func (g *generator) parseParts(parts []string, alias string) (*chain, error) {
+	c := &chain{}
+	for i := 0; i < len(parts); i++ {
+		part := parts[i]
+		switch part {
+		case "omitempty":
+			if i != 0 || alias != "" {
+				return nil, fmt.Errorf("omitempty must be the first tag")
+			}
+			c.omitEmpty = true
+		case "dive":
+			if alias != "" {
+				return nil, fmt.Errorf("dive inside alias %s is not supported", alias)
+			}
+			c.dive = true
+			rest := parts[i+1:]
+			if len(rest) > 0 && rest[0] == "keys" {
+				end := -1
+				for j, tag := range rest {
+					if tag == "endkeys" {
+						end = j
+						break
+					}
+				}
+				if end < 0 {
+					return nil, fmt.Errorf("keys without endkeys")
+				}
+
+				keys, err := g.parseParts(rest[1:end], "")
+				if err != nil {
+					return nil, err
+				}
+				c.keys = keys
+				rest = rest[end+1:]
+			}
+
+			elem, err := g.parseParts(rest, "")
+			if err != nil {
+				return nil, err
+			}
+			c.elem = elem
+			return c, nil
+		case "keys", "endkeys":
+			return nil, fmt.Errorf("%s must follow dive", part)
+		default:
+			if expanded, ok := g.validator.Alias(part); ok && alias == "" {
+				aliased, err := g.parseParts(strings.Split(expanded, ","), part)
+				if err != nil {
+					return nil, err
+				}
+				c.checks = append(c.checks, aliased.checks...)
+				continue
+			}
+
+			var current check
+			current.alias = alias
+			for _, or := range strings.Split(part, "|") {
+				tag, param, _ := strings.Cut(or, "=")
+				param = strings.NewReplacer("0x2C", ",", "0x7C", "|").Replace(param)
+				if _, ok := g.validator.Alias(tag); ok {
+					return nil, fmt.Errorf("alias %s inside or is not supported", tag)
+				}
+				current.rules = append(current.rules, rule{tag: tag, param: param})
+			}
+			c.checks = append(c.checks, current)
+		}
+	}
+
+	return c, nil
+}
+
+// hasStruct mengecek tipe berisi struct yang harus divalidasi walaupun tidak memiliki tag, sama seperti validator package
+func hasStruct(typ *fieldType) bool {
+	return typ.kind == kindStruct || (typ.kind == kindPointer && typ.elem.kind == kindStruct)
+}
+
+// paren memberi tanda kurung untuk expression pointer, contoh *x.Address menjadi (*x.Address)
+func paren(value string) string {
+	if strings.HasPrefix(value, "*") {
+		return "(" + value + ")"
+	}
+
+	return value
+}
+
+// value membuat kode validasi untuk satu value, deref bernilai true jika value berasal dari pointer yang tidak nil
+func (g *generator) value(w *bytes.Buffer, value string, typ *fieldType, c *chain, pathVar string, deref bool, current field) error {
+	if typ.kind == kindPointer {
+		return g.pointer(w, value, typ, c, pathVar, current)
+	}
+
+	if c.empty() {
+		if typ.kind == kindStruct {
+			g.enqueue(typ.name)
+			fmt.Fprintf(w, "%s.validateInto(&%s, errs)\n", paren(value), pathVar)
+		}
+		return nil
+	}
+
+	if typ.kind == kindOther {
+		return fmt.Errorf("type %s is not supported", typ.expr)
+	}
+
+	checks := c.checks
+	if typ.kind == kindStruct {
+		if len(checks) > 0 && checks[0].alias == "" && len(checks[0].rules) == 1 && checks[0].rules[0].tag == "required" {
+			// sama dengan validator package, required pada struct non pointer tidak dicek
+			checks = checks[1:]
+		}
+		if c.omitEmpty || len(checks) > 0 || c.dive {
+			return fmt.Errorf("tags on struct %s are not supported", typ.expr)
+		}
+	}
+
+	closing := ""
+	if c.omitEmpty && !deref {
+		notEmpty, err := hasValue(value, typ)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "if %s {\n", notEmpty)
+		closing = "}\n"
+	}
+
+	var cases bytes.Buffer
+	for _, check := range checks {
+		condition, always, err := g.condition(check, value, typ, deref, current)
+		if err != nil {
+			return err
+		}
+		if always {
+			continue
+		}
+
+		tag, actualTag, param := errorTag(check)
+		fmt.Fprintf(&cases, "case !(%s):\nerrs.Add(&%s, %q, %q, %q, %s)\n", condition, pathVar, tag, actualTag, param, value)
+	}
+
+	var next bytes.Buffer
+	if c.dive {
+		if err := g.dive(&next, value, typ, c, pathVar, current); err != nil {
+			return err
+		}
+	} else if typ.kind == kindStruct {
+		g.enqueue(typ.name)
+		fmt.Fprintf(&next, "%s.validateInto(&%s, errs)\n", paren(value), pathVar)
+	}
+
+	if cases.Len() == 0 {
+		w.Write(next.Bytes())
+	} else {
+		fmt.Fprintf(w, "switch {\n%s", cases.String())
+		if next.Len() > 0 {
+			fmt.Fprintf(w, "default:\n%s", next.String())
+		}
+		w.WriteString("}\n")
+	}
+	w.WriteString(closing)
+
+	return nil
+}
+
+// pointer membuat kode validasi untuk field pointer, pointer nil gagal pada tag pertama kecuali omitempty
+func (g *generator) pointer(w *bytes.Buffer, value string, typ *fieldType, c *chain, pathVar string, current field) error {
+	var inner bytes.Buffer
+
+	if c.empty() {
+		if !hasStruct(typ.elem) {
+			return nil
+		}
+		if err := g.value(&inner, "*"+value, typ.elem, nil, pathVar, true, current); err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "if %s != nil {\n%s}\n", value, inner.String())
+		return nil
+	}
+
+	if c.omitEmpty {
+		rest := *c
+		rest.omitEmpty = false
+		if err := g.value(&inner, "*"+value, typ.elem, &rest, pathVar, true, current); err != nil {
+			return err
+		}
+		if inner.Len() > 0 {
+			fmt.Fprintf(w, "if %s != nil {\n%s}\n", value, inner.String())
+		}
+		return nil
+	}
+
+	if err := g.value(&inner, "*"+value, typ.elem, c, pathVar, true, current); err != nil {
+		return err
+	}
+
+	tag, actualTag, param := "dive", "dive", ""
+	if len(c.checks) > 0 {
+		first := c.checks[0]
+		tag, actualTag, param = first.rules[0].tag, first.rules[0].tag, first.rules[0].param
+		if first.alias != "" {
+			tag = first.alias
+		}
+	}
+
+	fmt.Fprintf(w, "if %s == nil {\nerrs.Add(&%s, %q, %q, %q, %s)\n}", value, pathVar, tag, actualTag, param, value)
+	if inner.Len() > 0 {
+		fmt.Fprintf(w, " else {\n%s}", inner.String())
+	}
+	w.WriteString("\n")
+
+	return nil
+}
+
+// dive membuat kode validasi untuk setiap element slice atau map
+func (g *generator) dive(w *bytes.Buffer, value string, typ *fieldType, c *chain, pathVar string, current field) error {
+	switch typ.kind {
+	case kindSlice:
+		index := g.newVar("i")
+		elementPath := g.newVar("path")
+
+		var element bytes.Buffer
+		if err := g.value(&element, paren(value)+"["+index+"]", typ.elem, c.elem, elementPath, false, current); err != nil {
+			return err
+		}
+		if element.Len() > 0 {
+			fmt.Fprintf(w, "for %s := range %s {\n%s := %s.Index(%s)\n%s}\n", index, value, elementPath, pathVar, index, element.String())
+		}
+	case kindMap:
+		if typ.key.kind != kindString {
+			return fmt.Errorf("map key %s is not supported, only string keys", typ.key.expr)
+		}
+
+		key := g.newVar("k")
+		element := g.newVar("v")
+		elementPath := g.newVar("path")
+
+		var keyCode, elementCode bytes.Buffer
+		if err := g.value(&keyCode, key, typ.key, c.keys, elementPath, false, current); err != nil {
+			return err
+		}
+		if err := g.value(&elementCode, element, typ.elem, c.elem, elementPath, false, current); err != nil {
+			return err
+		}
+		if keyCode.Len() == 0 && elementCode.Len() == 0 {
+			return nil
+		}
+
+		if elementCode.Len() > 0 {
+			fmt.Fprintf(w, "for %s, %s := range %s {\n", key, element, value)
+		} else {
+			fmt.Fprintf(w, "for %s := range %s {\n", key, value)
+		}
+		fmt.Fprintf(w, "%s := %s.Key(%s)\n%s%s}\n", elementPath, pathVar, asString(key, typ.key), keyCode.String(), elementCode.String())
+	default:
+		return fmt.Errorf("dive on %s is not supported", typ.expr)
+	}
+
+	return nil
+}
+
+// asString mengubah value dengan tipe turunan string menjadi string, contoh type Code string
+func asString(value string, typ *fieldType) string {
+	if typ.expr == "string" {
+		return value
+	}
+
+	return "string(" + value + ")"
+}
+
+// errorTag mengembalikan tag, actual tag dan param untuk error, sama dengan FieldError dari validator package
+// tag OR digabung dengan |, contoh email|phone_id
+func errorTag(c check) (tag, actualTag, param string) {
+	names := make([]string, len(c.rules))
+	for i, r := range c.rules {
+		names[i] = r.tag
+		if len(c.rules) > 1 && r.param != "" {
+			names[i] += "=" + r.param
+		}
+	}
+
+	tag = strings.Join(names, "|")
+	actualTag = tag
+	if c.alias != "" {
+		tag = c.alias
+	}
+
+	return tag, actualTag, c.rules[len(c.rules)-1].param
+}
+
+// hasValue membuat expression untuk omitempty
+func hasValue(value string, typ *fieldType) (string, error) {
+	switch typ.kind {
+	case kindString:
+		return value + ` != ""`, nil
+	case kindBool:
+		return value, nil
+	case kindInt, kindUint, kindFloat:
+		return value + " != 0", nil
+	case kindSlice, kindMap:
+		return value + " != nil", nil
+	}
+
+	return "", fmt.Errorf("omitempty on %s is not supported", typ.expr)
+}
+
+// condition membuat expression yang bernilai true jika check valid, always bernilai true jika check pasti valid
+func (g *generator) condition(c check, value string, typ *fieldType, deref bool, current field) (string, bool, error) {
+	conditions := make([]string, 0, len(c.rules))
+	for _, r := range c.rules {
+		condition, always, err := g.ruleCondition(r, value, typ, deref, current)
+		if err != nil {
+			return "", false, err
+		}
+		if always {
+			return "", true, nil
+		}
+		conditions = append(conditions, condition)
+	}
+
+	return strings.Join(conditions, " || "), false, nil
+}
+
+var sizeOperators = map[string]string{
+	"min": ">=",
+	"max": "<=",
+	"len": "==",
+	"gt":  ">",
+	"gte": ">=",
+	"lt":  "<",
+	"lte": "<=",
+}
+
+var stringFuncs = map[string]string{
+	"email":    "generated.IsEmail",
+	"number":   "generated.IsNumber",
+	"numeric":  "generated.IsNumeric",
+	"alpha":    "generated.IsAlpha",
+	"alphanum": "generated.IsAlphanum",
+}
+
+func (g *generator) ruleCondition(r rule, value string, typ *fieldType, deref bool, current field) (string, bool, error) {
+	unsupported := fmt.Errorf("tag %s on %s is not supported by validategen", r.tag, typ.expr)
+
+	switch r.tag {
+	case "required":
+		if deref {
+			return "", true, nil
+		}
+		condition, err := hasValue(value, typ)
+		return condition, false, err
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		operator := sizeOperators[r.tag]
+		switch typ.kind {
+		case kindString:
+			if _, err := strconv.Atoi(r.param); err != nil {
+				return "", false, fmt.Errorf("tag %s: invalid param %q", r.tag, r.param)
+			}
+			return fmt.Sprintf("generated.Length(%s) %s %s", value, operator, r.param), false, nil
+		case kindSlice, kindMap:
+			if _, err := strconv.Atoi(r.param); err != nil {
+				return "", false, fmt.Errorf("tag %s: invalid param %q", r.tag, r.param)
+			}
+			return fmt.Sprintf("len(%s) %s %s", value, operator, r.param), false, nil
+		case kindInt:
+			if _, err := strconv.ParseInt(r.param, 0, 64); err != nil {
+				return "", false, fmt.Errorf("tag %s: invalid param %q", r.tag, r.param)
+			}
+		case kindUint:
+			if _, err := strconv.ParseUint(r.param, 0, 64); err != nil {
+				return "", false, fmt.Errorf("tag %s: invalid param %q", r.tag, r.param)
+			}
+		case kindFloat:
+			if _, err := strconv.ParseFloat(r.param, 64); err != nil {
+				return "", false, fmt.Errorf("tag %s: invalid param %q", r.tag, r.param)
+			}
+		default:
+			return "", false, unsupported
+		}
+		return fmt.Sprintf("%s %s %s", value, operator, r.param), false, nil
+	case "eqfield", "nefield":
+		other, ok := current.parent[r.param]
+		if !ok || other.expr != typ.expr || typ.kind < kindString || typ.kind > kindFloat {
+			return "", false, unsupported
+		}
+		operator := "=="
+		if r.tag == "nefield" {
+			operator = "!="
+		}
+		return fmt.Sprintf("%s %s x.%s", value, operator, r.param), false, nil
+	case "email", "number", "numeric", "alpha", "alphanum":
+		if typ.kind == kindString {
+			return fmt.Sprintf("%s(%s)", stringFuncs[r.tag], asString(value, typ)), false, nil
+		}
+		if (r.tag == "number" || r.tag == "numeric") && typ.kind >= kindInt && typ.kind <= kindFloat {
+			return "", true, nil
+		}
+		return "", false, unsupported
+	}
+
+	if !generated.Registered(r.tag) {
+		return "", false, unsupported
+	}
+
+	return fmt.Sprintf("generated.Custom(%q, generated.CustomField{Value: %s, Param: %q, Parent: x, FieldName: %q, StructFieldName: %q})",
+		r.tag, value, r.param, current.name, current.structName), false, nil
+}
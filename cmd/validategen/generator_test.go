@@ -0,0 +1,49 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerateModelUpToDate(t *testing.T) {
+	dir := filepath.Join("..", "..", "model")
+	source, err := Generate(dir, []string{"RegisterRequest", "LoginRequest", "RegisterUser", "User"}, "validate_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	committed, err := os.ReadFile(filepath.Join(dir, "validate_gen.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(source, committed) {
+		t.Error("model/validate_gen.go is out of date, run go generate ./model")
+	}
+}
+
+func TestGenerateUnsupportedTag(t *testing.T) {
+	tests := map[string]string{
+		"unsupported tag": "`validate:\"required,uuid4\"`",
+		"unknown field":   "`validate:\"eqfield=Missing\"`",
+		"invalid param":   "`validate:\"pin=abc\"`",
+	}
+
+	for name, tag := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			source := "package sample\n\ntype Request struct {\n\tCode string " + tag + "\n}\n"
+			if err := os.WriteFile(filepath.Join(dir, "sample.go"), []byte(source), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := Generate(dir, []string{"Request"}, "validate_gen.go")
+			if err == nil || !strings.Contains(err.Error(), "Code") {
+				t.Errorf("expected error for field Code, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// validategen membuat method Validate() tanpa reflection dari tag validate, untuk hot path yang sensitif terhadap biaya reflection
+// hasil Validate() sama dengan validation.Default().Validate.Struct, berupa validator.ValidationErrors
+//
+//	//go:generate go run belajar-go-lang-validation/cmd/validategen -type User,RegisterRequest
+//
+// struct lain di package yang sama yang dipakai oleh tipe tersebut (contoh Address di dalam User) ikut dibuatkan
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typeNames := flag.String("type", "", "daftar nama struct dipisah koma, wajib diisi")
+	output := flag.String("output", "validate_gen.go", "nama file hasil generate")
+	flag.Parse()
+
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	dir := "."
+	if flag.NArg() > 0 {
+		dir = flag.Arg(0)
+	}
+
+	source, err := Generate(dir, strings.Split(*typeNames, ","), filepath.Base(*output))
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "validategen:", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, *output), source, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "validategen:", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,15 @@
+// validatelint mengecek struct tag validate, bisa dijalankan langsung atau melalui go vet
+//
+//	validatelint ./...
+//	go vet -vettool=$(which validatelint) ./...
+package main
+
+import (
+	"belajar-go-lang-validation/lint"
+
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+func main() {
+	singlechecker.Main(lint.Analyzer)
+}
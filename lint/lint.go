@@ -0,0 +1,187 @@
+// package lint berisi analyzer go vet untuk mengecek struct tag validate dan mod saat compile time
+//
+// analyzer ini menggunakan aturan yang sama dengan Validator.CheckStructs: key tag yang salah tulis,
+// tag yang tidak dikenal, parameter tag custom, referensi ke field yang tidak ada dan modifier tag mod
+package lint
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"belajar-go-lang-validation/validation"
+
+	"github.com/go-playground/validator/v10"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Analyzer mengecek struct tag validate di seluruh struct dalam package
+var Analyzer = &analysis.Analyzer{
+	Name:     "validatetag",
+	Doc:      "check validate and mod struct tags for malformed keys, unknown tags, references to missing fields and unknown modifiers",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// extraTags berisi tag custom yang di register di luar package validation, dipisah koma (flag -custom)
+// extraModifiers berisi modifier yang di register dengan WithModifier di luar package validation (flag -modifiers)
+var extraTags, extraModifiers string
+
+func init() {
+	Analyzer.Flags.StringVar(&extraTags, "custom", "", "comma separated custom tags registered outside the validation package")
+	Analyzer.Flags.StringVar(&extraModifiers, "modifiers", "", "comma separated mod modifiers registered outside the validation package")
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	validate, err := validatorWithExtraTags()
+	if err != nil {
+		return nil, err
+	}
+
+	inspect := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	inspect.Preorder([]ast.Node{(*ast.StructType)(nil)}, func(node ast.Node) {
+		structType := node.(*ast.StructType)
+
+		typ, ok := pass.TypesInfo.TypeOf(structType).(*types.Struct)
+		if !ok {
+			return
+		}
+
+		hasField := func(path string) bool {
+			return hasFieldPath(typ, path)
+		}
+
+		for _, field := range structType.Fields.List {
+			if field.Tag == nil {
+				continue
+			}
+
+			raw, err := strconv.Unquote(field.Tag.Value)
+			if err != nil {
+				continue
+			}
+
+			issues := validation.CheckTagKeys(raw)
+			if tag, ok := reflect.StructTag(raw).Lookup(validation.ValidateTagKey); ok && tag != "-" {
+				issues = append(issues, validate.LintTag(tag, hasField)...)
+			}
+			if tag, ok := reflect.StructTag(raw).Lookup(validation.ModTagKey); ok {
+				issues = append(issues, validate.LintMod(tag)...)
+				if fieldType := pass.TypesInfo.TypeOf(field.Type); !modTarget(fieldType) {
+					issues = append(issues, validation.ConfigIssue{Tag: tag, Message: fmt.Sprintf("mod tag needs a string field, got %s", fieldType)})
+				}
+			}
+
+			for _, issue := range issues {
+				pass.Reportf(field.Tag.Pos(), "%s: tag %q: %s", fieldName(field), issue.Tag, issue.Message)
+			}
+		}
+	})
+
+	return nil, nil
+}
+
+// validatorWithExtraTags membuat Validator yang juga mengenal tag dari flag -custom dan modifier dari flag -modifiers
+func validatorWithExtraTags() (*validation.Validator, error) {
+	if extraTags == "" && extraModifiers == "" {
+		return validation.Default(), nil
+	}
+
+	var opts []validation.Option
+	for _, name := range splitList(extraModifiers) {
+		opts = append(opts, validation.WithModifier(name, func(value string) string { return value }))
+	}
+
+	validate, err := validation.New(opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tag := range splitList(extraTags) {
+		if err := validate.RegisterValidation(tag, func(validator.FieldLevel) bool { return true }); err != nil {
+			return nil, err
+		}
+	}
+
+	return validate, nil
+}
+
+// splitList memecah isi flag yang dipisah koma, nama kosong diabaikan
+func splitList(list string) []string {
+	var names []string
+	for _, name := range strings.Split(list, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+// modTarget mengecek tag mod dipasang di field string, termasuk pointer, slice, array dan map berisi string
+// sama dengan pengecekan tipe di Validator.CheckStructs
+func modTarget(typ types.Type) bool {
+	for {
+		switch t := typ.Underlying().(type) {
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		case *types.Array:
+			typ = t.Elem()
+		case *types.Map:
+			typ = t.Elem()
+		case *types.Basic:
+			return t.Info()&types.IsString != 0
+		case *types.Interface:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
+// fieldName mengambil nama field untuk pesan error, field embedded menggunakan nama tipenya
+func fieldName(field *ast.Field) string {
+	if len(field.Names) > 0 {
+		return field.Names[0].Name
+	}
+
+	return types.ExprString(field.Type)
+}
+
+// hasFieldPath mengecek field ada di struct, path boleh bertingkat menggunakan titik, contoh Address.City
+func hasFieldPath(typ types.Type, path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		for {
+			pointer, ok := typ.Underlying().(*types.Pointer)
+			if !ok {
+				break
+			}
+			typ = pointer.Elem()
+		}
+
+		structType, ok := typ.Underlying().(*types.Struct)
+		if !ok {
+			return false
+		}
+
+		found := false
+		for i := 0; i < structType.NumFields(); i++ {
+			if structType.Field(i).Name() == name {
+				typ, found = structType.Field(i).Type(), true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
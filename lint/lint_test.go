@@ -0,0 +1,37 @@
+package lint
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.Run(t, analysistest.TestData(), Analyzer, "a")
+}
+
+func TestAnalyzerExtraTags(t *testing.T) {
+	extraTags = "company"
+	defer func() { extraTags = "" }()
+
+	validate, err := validatorWithExtraTags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !validate.KnownTag("company") {
+		t.Error("company must be known")
+	}
+}
+
+func TestAnalyzerExtraModifiers(t *testing.T) {
+	extraModifiers = "company_name"
+	defer func() { extraModifiers = "" }()
+
+	validate, err := validatorWithExtraTags()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issues := validate.LintMod("trim,company_name"); len(issues) != 0 {
+		t.Errorf("company_name must be known, got %+v", issues)
+	}
+}
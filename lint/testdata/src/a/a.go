@@ -0,0 +1,30 @@
+package a
+
+type RegisterRequest struct {
+	Username string `validate="required"`       // want `Username: tag "validate=\\"": malformed struct tag`
+	Email    string `valdiate:"required,email"` // want `Email: tag "valdiate:\\"": misspelled struct tag key "valdiate"`
+	Phone    string `validate:"required,phone_id"`
+	Password string `validate:"required,password=strong"`
+	Backup   string `validate:"omitempty,email,available=email"`
+	Referral string `validate:"omitempty,available"` // want `parameter must name the kind of value`
+}
+
+type RegisterUser struct {
+	Username        string `validate:"required,emial"`                                                   // want `unknown tag "emial"`
+	Password        string `validate:"required,pin=enam"`                                                // want `parameter must be a positive integer`
+	ConfirmPassword string `validate:"required,eqfield=Pasword"`                                         // want `field "Pasword" does not exist`
+	Nickname        string `validate:"field_equals_ignore_case=Username|field_equals_ignore_case=Phone"` // want `field "Phone" does not exist`
+	Address         struct {
+		City string `validate:"varchar"`
+	}
+	Country string `validate:"eqfield=Address.City,company"` // want `unknown tag "company"`
+}
+
+type Profile struct {
+	Name    string            `mod:"trim,collapse"`
+	Email   *string           `mod:"trim,lowre"` // want `unknown modifier "lowre"`
+	Tags    []string          `mod:"lower"`
+	Labels  map[string]string `mod:"upper"`
+	Age     int               `mod:"trim"`         // want `mod tag needs a string field, got int`
+	Company string            `mod:"company_name"` // want `unknown modifier "company_name"`
+}
@@ -0,0 +1,48 @@
+// package model berisi struct request yang digunakan bersama oleh seluruh service
+// struct di sini sama dengan struct yang dipakai di validation_test.go, tapi dengan tag validate yang benar
+package model
+
+//go:generate go run belajar-go-lang-validation/cmd/validategen -type RegisterRequest,LoginRequest,RegisterUser,User
+
+// RegisterRequest digunakan untuk proses registrasi user
+// username wajib sama dengan email atau phone, dicek oleh struct level validation MustValidRegisterSuccess
+type RegisterRequest struct {
+	Username string `json:"username" validate:"required" mod:"trim,lower" example:"akuutauf@email.com"`
+	Email    string `json:"email" validate:"required,email" mod:"trim,lower" example:"akuutauf@email.com"`
+	Phone    string `json:"phone" validate:"required,phone_id" mod:"trim" example:"081234567890"`
+	Password string `json:"password" validate:"required" example:"rahasia"`
+}
+
+// LoginRequest digunakan untuk proses login, username berupa email
+type LoginRequest struct {
+	Username string `json:"username" validate:"required,email" mod:"trim,lower" example:"akuutauf@email.com"`
+	Password string `json:"password" validate:"required,min=5" example:"rahasia"`
+}
+
+// RegisterUser digunakan untuk registrasi dengan konfirmasi password, password wajib mengikuti policy strong
+type RegisterUser struct {
+	Username        string `json:"username" validate:"required,email" mod:"trim,lower" example:"akuutauf@email.com"`
+	Password        string `json:"password" validate:"required,password=strong" example:"Rahasia#2024"`
+	ConfirmPassword string `json:"confirm_password" validate:"required,eqfield=Password" example:"Rahasia#2024"`
+}
+
+// Address adalah alamat user, satu user bisa memiliki lebih dari satu alamat
+type Address struct {
+	City    string `json:"city" validate:"required" mod:"collapse,nfc" example:"Malang"`
+	Country string `json:"country" validate:"required" mod:"collapse,nfc" example:"Indonesia"`
+}
+
+// School adalah sekolah user, disimpan di map dengan key jenjang sekolah, contoh SD atau SMP
+type School struct {
+	Name string `json:"name" validate:"required" mod:"collapse,nfc" example:"SMP Negeri 1 Malang"`
+}
+
+// User adalah data user lengkap dengan collection dan map, key Wallets adalah kode bank, contoh BCA
+type User struct {
+	Id      string            `json:"id" validate:"required" example:"1"`
+	Name    string            `json:"name" validate:"required" mod:"collapse,nfc" example:"Taufik"`
+	Address []Address         `json:"address" validate:"required,dive"`
+	Hobbies []string          `json:"hobbies" validate:"required,dive,required,min=3" mod:"collapse" example:"[\"Coding\",\"Reading\"]"`
+	Schools map[string]School `json:"schools" validate:"dive,keys,required,min=2,endkeys"`
+	Wallets map[string]int    `json:"wallets" validate:"dive,keys,required,bank_code,endkeys,required,gt=1000" example:"{\"BCA\":150000}"`
+}
@@ -0,0 +1,107 @@
+package model_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/validation"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// summarize mengubah error menjadi daftar string yang terurut, karena urutan map di validator package acak
+func summarize(t *testing.T, err error) []string {
+	t.Helper()
+	if err == nil {
+		return nil
+	}
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		t.Fatalf("expected validator.ValidationErrors, got %T", err)
+	}
+
+	result := make([]string, 0, len(validationErrors))
+	for _, fe := range validationErrors {
+		result = append(result, fmt.Sprintf("%s|%s|%s|%s|%s|%s|%s|%v|%s",
+			fe.Namespace(), fe.StructNamespace(), fe.Field(), fe.StructField(), fe.Tag(), fe.ActualTag(), fe.Param(), fe.Value(), fe.Kind()))
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+func TestGeneratedValidateSameAsStruct(t *testing.T) {
+	validate := validation.Default()
+
+	tests := []interface{ Validate() error }{
+		model.RegisterRequest{},
+		model.RegisterRequest{Username: "aku@example.com", Email: "aku@example.com", Phone: "081234567890", Password: "rahasia"},
+		model.RegisterRequest{Username: "akuutauf", Email: "bukan email", Phone: "0812", Password: "rahasia"},
+		model.LoginRequest{Username: "aku@example.com", Password: "1234"},
+		model.RegisterUser{Username: "aku@example.com", Password: "lemah", ConfirmPassword: "beda"},
+		model.RegisterUser{Username: "aku@example.com", Password: "Rahasia#2024", ConfirmPassword: "Rahasia#2024"},
+		model.User{},
+		model.User{
+			Id:      "1",
+			Name:    "Taufik",
+			Address: []model.Address{{City: "Malang", Country: "Indonesia"}, {}},
+			Hobbies: []string{"Coding", "", "Go"},
+			Schools: map[string]model.School{"SD": {Name: "SD 1"}, "S": {}},
+			Wallets: map[string]int{"BCA": 5000, "XYZ": 10, "": 2000},
+		},
+	}
+
+	for _, value := range tests {
+		t.Run(reflect.TypeOf(value).Name(), func(t *testing.T) {
+			expected := summarize(t, validate.Validate.Struct(value))
+			actual := summarize(t, value.Validate())
+
+			if !reflect.DeepEqual(expected, actual) {
+				t.Errorf("generated Validate differs from validate.Struct\nexpected %v\nactual   %v", expected, actual)
+			}
+		})
+	}
+}
+
+func TestGeneratedValidateTranslation(t *testing.T) {
+	validate := validation.Default()
+	request := model.LoginRequest{Username: "bukan email", Password: "1234"}
+
+	for _, locale := range []string{validation.LocaleEN, validation.LocaleID} {
+		expected := validate.Translate(request.Validate(), locale)
+		actual := validate.Translate(validate.Struct(request), locale)
+
+		if !reflect.DeepEqual(expected, actual) {
+			t.Errorf("locale %s: expected %v, got %v", locale, expected, actual)
+		}
+	}
+}
+
+func BenchmarkUserValidate(b *testing.B) {
+	user := model.User{
+		Id:      "1",
+		Name:    "Taufik",
+		Address: []model.Address{{City: "Malang", Country: "Indonesia"}},
+		Hobbies: []string{"Coding", "Reading"},
+		Schools: map[string]model.School{"SD": {Name: "SD 1"}},
+		Wallets: map[string]int{"BCA": 5000},
+	}
+
+	b.Run("generated", func(b *testing.B) {
+		for b.Loop() {
+			_ = user.Validate()
+		}
+	})
+
+	b.Run("reflection", func(b *testing.B) {
+		validate := validation.Default()
+		for b.Loop() {
+			_ = validate.Validate.Struct(user)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package model
+
+import "sort"
+
+// types adalah struct yang bisa dibuat berdasarkan nama, contoh untuk CLI govalidate
+var types = map[string]func() any{
+	"RegisterRequest": func() any { return &RegisterRequest{} },
+	"LoginRequest":    func() any { return &LoginRequest{} },
+	"RegisterUser":    func() any { return &RegisterUser{} },
+	"Address":         func() any { return &Address{} },
+	"School":          func() any { return &School{} },
+	"User":            func() any { return &User{} },
+}
+
+// New membuat pointer ke struct baru berdasarkan nama tipe, contoh New("User") menghasilkan *User
+func New(name string) (any, bool) {
+	create, ok := types[name]
+	if !ok {
+		return nil, false
+	}
+
+	return create(), true
+}
+
+// TypeNames mengembalikan seluruh nama tipe yang bisa dibuat oleh New, diurutkan berdasarkan nama
+func TypeNames() []string {
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,261 @@
+// Code generated by validategen. DO NOT EDIT.
+
+package model
+
+import "belajar-go-lang-validation/validation/generated"
+
+// Validate memvalidasi RegisterRequest tanpa reflection, hasilnya sama dengan validate.Struct
+func (x RegisterRequest) Validate() error {
+	var errs generated.Errors
+	root := generated.Root("RegisterRequest")
+	x.validateInto(&root, &errs)
+	return errs.Err()
+}
+
+func (x *RegisterRequest) validateInto(path *generated.Path, errs *generated.Errors) {
+	{
+		path1 := path.Field("username", "Username")
+		switch {
+		case !(x.Username != ""):
+			errs.Add(&path1, "required", "required", "", x.Username)
+		}
+	}
+	{
+		path2 := path.Field("email", "Email")
+		switch {
+		case !(x.Email != ""):
+			errs.Add(&path2, "required", "required", "", x.Email)
+		case !(generated.IsEmail(x.Email)):
+			errs.Add(&path2, "email", "email", "", x.Email)
+		}
+	}
+	{
+		path3 := path.Field("phone", "Phone")
+		switch {
+		case !(x.Phone != ""):
+			errs.Add(&path3, "required", "required", "", x.Phone)
+		case !(generated.Custom("phone_id", generated.CustomField{Value: x.Phone, Param: "", Parent: x, FieldName: "phone", StructFieldName: "Phone"})):
+			errs.Add(&path3, "phone_id", "phone_id", "", x.Phone)
+		}
+	}
+	{
+		path4 := path.Field("password", "Password")
+		switch {
+		case !(x.Password != ""):
+			errs.Add(&path4, "required", "required", "", x.Password)
+		}
+	}
+	if fn := generated.LookupStructLevel("model.RegisterRequest"); fn != nil {
+		errs.Report(path, fn(*x))
+	}
+}
+
+// Validate memvalidasi LoginRequest tanpa reflection, hasilnya sama dengan validate.Struct
+func (x LoginRequest) Validate() error {
+	var errs generated.Errors
+	root := generated.Root("LoginRequest")
+	x.validateInto(&root, &errs)
+	return errs.Err()
+}
+
+func (x *LoginRequest) validateInto(path *generated.Path, errs *generated.Errors) {
+	{
+		path5 := path.Field("username", "Username")
+		switch {
+		case !(x.Username != ""):
+			errs.Add(&path5, "required", "required", "", x.Username)
+		case !(generated.IsEmail(x.Username)):
+			errs.Add(&path5, "email", "email", "", x.Username)
+		}
+	}
+	{
+		path6 := path.Field("password", "Password")
+		switch {
+		case !(x.Password != ""):
+			errs.Add(&path6, "required", "required", "", x.Password)
+		case !(generated.Length(x.Password) >= 5):
+			errs.Add(&path6, "min", "min", "5", x.Password)
+		}
+	}
+	if fn := generated.LookupStructLevel("model.LoginRequest"); fn != nil {
+		errs.Report(path, fn(*x))
+	}
+}
+
+// Validate memvalidasi RegisterUser tanpa reflection, hasilnya sama dengan validate.Struct
+func (x RegisterUser) Validate() error {
+	var errs generated.Errors
+	root := generated.Root("RegisterUser")
+	x.validateInto(&root, &errs)
+	return errs.Err()
+}
+
+func (x *RegisterUser) validateInto(path *generated.Path, errs *generated.Errors) {
+	{
+		path7 := path.Field("username", "Username")
+		switch {
+		case !(x.Username != ""):
+			errs.Add(&path7, "required", "required", "", x.Username)
+		case !(generated.IsEmail(x.Username)):
+			errs.Add(&path7, "email", "email", "", x.Username)
+		}
+	}
+	{
+		path8 := path.Field("password", "Password")
+		switch {
+		case !(x.Password != ""):
+			errs.Add(&path8, "required", "required", "", x.Password)
+		case !(generated.Custom("password", generated.CustomField{Value: x.Password, Param: "strong", Parent: x, FieldName: "password", StructFieldName: "Password"})):
+			errs.Add(&path8, "password", "password", "strong", x.Password)
+		}
+	}
+	{
+		path9 := path.Field("confirm_password", "ConfirmPassword")
+		switch {
+		case !(x.ConfirmPassword != ""):
+			errs.Add(&path9, "required", "required", "", x.ConfirmPassword)
+		case !(x.ConfirmPassword == x.Password):
+			errs.Add(&path9, "eqfield", "eqfield", "Password", x.ConfirmPassword)
+		}
+	}
+	if fn := generated.LookupStructLevel("model.RegisterUser"); fn != nil {
+		errs.Report(path, fn(*x))
+	}
+}
+
+// Validate memvalidasi User tanpa reflection, hasilnya sama dengan validate.Struct
+func (x User) Validate() error {
+	var errs generated.Errors
+	root := generated.Root("User")
+	x.validateInto(&root, &errs)
+	return errs.Err()
+}
+
+func (x *User) validateInto(path *generated.Path, errs *generated.Errors) {
+	{
+		path10 := path.Field("id", "Id")
+		switch {
+		case !(x.Id != ""):
+			errs.Add(&path10, "required", "required", "", x.Id)
+		}
+	}
+	{
+		path11 := path.Field("name", "Name")
+		switch {
+		case !(x.Name != ""):
+			errs.Add(&path11, "required", "required", "", x.Name)
+		}
+	}
+	{
+		path12 := path.Field("address", "Address")
+		switch {
+		case !(x.Address != nil):
+			errs.Add(&path12, "required", "required", "", x.Address)
+		default:
+			for i13 := range x.Address {
+				path14 := path12.Index(i13)
+				x.Address[i13].validateInto(&path14, errs)
+			}
+		}
+	}
+	{
+		path15 := path.Field("hobbies", "Hobbies")
+		switch {
+		case !(x.Hobbies != nil):
+			errs.Add(&path15, "required", "required", "", x.Hobbies)
+		default:
+			for i16 := range x.Hobbies {
+				path17 := path15.Index(i16)
+				switch {
+				case !(x.Hobbies[i16] != ""):
+					errs.Add(&path17, "required", "required", "", x.Hobbies[i16])
+				case !(generated.Length(x.Hobbies[i16]) >= 3):
+					errs.Add(&path17, "min", "min", "3", x.Hobbies[i16])
+				}
+			}
+		}
+	}
+	{
+		path18 := path.Field("schools", "Schools")
+		for k19, v20 := range x.Schools {
+			path21 := path18.Key(k19)
+			switch {
+			case !(k19 != ""):
+				errs.Add(&path21, "required", "required", "", k19)
+			case !(generated.Length(k19) >= 2):
+				errs.Add(&path21, "min", "min", "2", k19)
+			}
+			v20.validateInto(&path21, errs)
+		}
+	}
+	{
+		path22 := path.Field("wallets", "Wallets")
+		for k23, v24 := range x.Wallets {
+			path25 := path22.Key(k23)
+			switch {
+			case !(k23 != ""):
+				errs.Add(&path25, "required", "required", "", k23)
+			case !(generated.Custom("bank_code", generated.CustomField{Value: k23, Param: "", Parent: x, FieldName: "wallets", StructFieldName: "Wallets"})):
+				errs.Add(&path25, "bank_code", "bank_code", "", k23)
+			}
+			switch {
+			case !(v24 != 0):
+				errs.Add(&path25, "required", "required", "", v24)
+			case !(v24 > 1000):
+				errs.Add(&path25, "gt", "gt", "1000", v24)
+			}
+		}
+	}
+	if fn := generated.LookupStructLevel("model.User"); fn != nil {
+		errs.Report(path, fn(*x))
+	}
+}
+
+// Validate memvalidasi Address tanpa reflection, hasilnya sama dengan validate.Struct
+func (x Address) Validate() error {
+	var errs generated.Errors
+	root := generated.Root("Address")
+	x.validateInto(&root, &errs)
+	return errs.Err()
+}
+
+func (x *Address) validateInto(path *generated.Path, errs *generated.Errors) {
+	{
+		path26 := path.Field("city", "City")
+		switch {
+		case !(x.City != ""):
+			errs.Add(&path26, "required", "required", "", x.City)
+		}
+	}
+	{
+		path27 := path.Field("country", "Country")
+		switch {
+		case !(x.Country != ""):
+			errs.Add(&path27, "required", "required", "", x.Country)
+		}
+	}
+	if fn := generated.LookupStructLevel("model.Address"); fn != nil {
+		errs.Report(path, fn(*x))
+	}
+}
+
+// Validate memvalidasi School tanpa reflection, hasilnya sama dengan validate.Struct
+func (x School) Validate() error {
+	var errs generated.Errors
+	root := generated.Root("School")
+	x.validateInto(&root, &errs)
+	return errs.Err()
+}
+
+func (x *School) validateInto(path *generated.Path, errs *generated.Errors) {
+	{
+		path28 := path.Field("name", "Name")
+		switch {
+		case !(x.Name != ""):
+			errs.Add(&path28, "required", "required", "", x.Name)
+		}
+	}
+	if fn := generated.LookupStructLevel("model.School"); fn != nil {
+		errs.Report(path, fn(*x))
+	}
+}
@@ -0,0 +1,82 @@
+package openapi
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+)
+
+// LoadComments membaca komentar struct dan field dari file go di dir, dipakai sebagai description di schema
+// key komentar sama dengan reflect.Type.String(), contoh model.User dan model.User.Address untuk field
+func (s *Spec) LoadComments(dirs ...string) error {
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			return err
+		}
+
+		fileSet := token.NewFileSet()
+		for _, path := range files {
+			if strings.HasSuffix(path, "_test.go") {
+				continue
+			}
+
+			file, err := parser.ParseFile(fileSet, path, nil, parser.ParseComments|parser.SkipObjectResolution)
+			if err != nil {
+				return err
+			}
+			s.addComments(file)
+		}
+	}
+
+	return nil
+}
+
+func (s *Spec) addComments(file *ast.File) {
+	for _, declaration := range file.Decls {
+		general, ok := declaration.(*ast.GenDecl)
+		if !ok || general.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range general.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			name := file.Name.Name + "." + typeSpec.Name.Name
+
+			doc := typeSpec.Doc
+			if doc == nil && len(general.Specs) == 1 {
+				doc = general.Doc
+			}
+			if text := commentText(doc); text != "" {
+				s.comments[name] = text
+			}
+
+			structType, ok := typeSpec.Type.(*ast.StructType)
+			if !ok {
+				continue
+			}
+			for _, field := range structType.Fields.List {
+				text := commentText(field.Doc)
+				if text == "" {
+					text = commentText(field.Comment)
+				}
+				for _, ident := range field.Names {
+					if text != "" {
+						s.comments[name+"."+ident.Name] = text
+					}
+				}
+			}
+		}
+	}
+}
+
+// commentText mengambil isi komentar tanpa tanda //, baris baru tetap dipertahankan
+func commentText(group *ast.CommentGroup) string {
+	if group == nil {
+		return ""
+	}
+
+	return strings.TrimSpace(group.Text())
+}
@@ -0,0 +1,356 @@
+// package openapi membuat dokumen OpenAPI 3.1 dari handler yang di register beserta tag validate struct request,
+// sehingga dokumentasi API selalu sama dengan rule yang dijalankan oleh validation
+//
+// schema di components/schemas dibuat oleh validation.SchemaComponents, deskripsi diambil dari komentar struct
+// (lihat LoadComments) dan contoh nilai diambil dari tag example
+package openapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"belajar-go-lang-validation/problem"
+	"belajar-go-lang-validation/validation"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Version adalah versi OpenAPI yang dihasilkan
+const Version = "3.1.0"
+
+// SchemaRef adalah prefix $ref ke components/schemas
+const SchemaRef = "#/components/schemas/"
+
+// ExampleTagKey adalah nama tag untuk contoh nilai field, contoh example:"akuutauf@email.com"
+const ExampleTagKey = "example"
+
+// ProblemSchema adalah nama schema untuk response problem+json
+const ProblemSchema = "Problem"
+
+// Info adalah informasi API di dokumen OpenAPI
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// Document adalah dokumen OpenAPI 3.1, hanya berisi bagian yang dihasilkan oleh package ini
+type Document struct {
+	OpenAPI    string                          `json:"openapi"`
+	Info       Info                            `json:"info"`
+	Paths      map[string]map[string]Operation `json:"paths"`
+	Components Components                      `json:"components"`
+}
+
+// Components adalah components di dokumen OpenAPI
+type Components struct {
+	Schemas map[string]*validation.Schema `json:"schemas"`
+}
+
+// Operation adalah satu method di satu path
+type Operation struct {
+	OperationID string              `json:"operationId,omitempty"`
+	Summary     string              `json:"summary,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter adalah parameter path, contoh {id} di /users/{id}
+type Parameter struct {
+	Name     string             `json:"name"`
+	In       string             `json:"in"`
+	Required bool               `json:"required"`
+	Schema   *validation.Schema `json:"schema"`
+}
+
+// RequestBody adalah body request dengan schema dari struct request
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response adalah satu response berdasarkan status code
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType adalah schema untuk satu content type
+type MediaType struct {
+	Schema *validation.Schema `json:"schema"`
+}
+
+// Route adalah informasi dokumentasi untuk satu handler
+type Route struct {
+	OperationID string
+	Summary     string
+	Description string
+	Tags        []string
+	// Request adalah struct body request, contoh model.LoginRequest{}, nil jika tanpa body
+	Request any
+	// Response adalah struct body response sukses, nil berarti response tanpa body
+	Response any
+	// Status adalah status code response sukses, default 200 atau 204 jika Response nil
+	Status int
+}
+
+type route struct {
+	method string
+	path   string
+	Route
+}
+
+// Spec mengumpulkan handler yang di register untuk dibuatkan dokumen OpenAPI
+type Spec struct {
+	validate *validation.Validator
+	info     Info
+	comments map[string]string
+	routes   []route
+}
+
+// New membuat Spec baru, validate digunakan untuk membaca alias dan policy tag custom
+func New(validate *validation.Validator, info Info) *Spec {
+	return &Spec{validate: validate, info: info, comments: map[string]string{}}
+}
+
+// Register mencatat dokumentasi handler dengan pattern yang sama seperti http.ServeMux, contoh POST /login
+func (s *Spec) Register(pattern string, r Route) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.routes = append(s.routes, route{method: method, path: strings.TrimSpace(path), Route: r})
+}
+
+// Handle mendaftarkan handler ke mux sekaligus mencatat dokumentasinya
+func (s *Spec) Handle(mux *http.ServeMux, pattern string, handler http.Handler, r Route) {
+	mux.Handle(pattern, handler)
+	s.Register(pattern, r)
+}
+
+// Document membuat dokumen OpenAPI dari seluruh handler yang di register
+func (s *Spec) Document() (*Document, error) {
+	document := &Document{
+		OpenAPI: Version,
+		Info:    s.info,
+		Paths:   map[string]map[string]Operation{},
+	}
+
+	types := []any{problem.Details{}}
+	for _, r := range s.routes {
+		for _, value := range []any{r.Request, r.Response} {
+			if value != nil {
+				types = append(types, value)
+			}
+		}
+	}
+
+	schemas, err := s.validate.SchemaComponents(SchemaRef, types...)
+	if err != nil {
+		return nil, err
+	}
+	details := validation.SchemaName(reflect.TypeOf(problem.Details{}))
+	schemas[ProblemSchema] = schemas[details]
+	delete(schemas, details)
+	s.describe(schemas, types[1:])
+	document.Components.Schemas = schemas
+
+	for _, r := range s.routes {
+		if r.method == "" || r.path == "" || strings.HasPrefix(r.method, "/") {
+			return nil, fmt.Errorf("openapi: pattern %q must contain a method and a path", r.method+" "+r.path)
+		}
+
+		path := strings.TrimSuffix(r.path, "{$}")
+		if document.Paths[path] == nil {
+			document.Paths[path] = map[string]Operation{}
+		}
+		document.Paths[path][strings.ToLower(r.method)] = r.operation(path)
+	}
+
+	return document, nil
+}
+
+// operation membuat Operation dari route, request dengan body mendapat response error dari problem.Bind
+func (r route) operation(path string) Operation {
+	operation := Operation{
+		OperationID: r.OperationID,
+		Summary:     r.Summary,
+		Description: r.Description,
+		Tags:        r.Tags,
+		Parameters:  pathParameters(path),
+		Responses:   map[string]Response{},
+	}
+
+	status := r.Status
+	switch {
+	case status == 0 && r.Response == nil:
+		status = http.StatusNoContent
+	case status == 0:
+		status = http.StatusOK
+	}
+
+	success := Response{Description: http.StatusText(status)}
+	if r.Response != nil {
+		success.Content = jsonContent("application/json", r.Response)
+	}
+	operation.Responses[strconv.Itoa(status)] = success
+
+	if r.Request != nil {
+		operation.RequestBody = &RequestBody{Required: true, Content: jsonContent("application/json", r.Request)}
+
+		// status yang bisa dikembalikan oleh problem.Bind, termasuk dari problem.FromError: 503 jika pengecekan tag
+		// available gagal dan 500 untuk error lain, contoh *validation.ConfigError
+		for _, status := range []int{
+			http.StatusBadRequest, http.StatusRequestEntityTooLarge, http.StatusUnsupportedMediaType, http.StatusUnprocessableEntity,
+			http.StatusInternalServerError, http.StatusServiceUnavailable,
+		} {
+			operation.Responses[strconv.Itoa(status)] = Response{
+				Description: http.StatusText(status),
+				Content:     map[string]MediaType{problem.ContentType: {Schema: &validation.Schema{Ref: SchemaRef + ProblemSchema}}},
+			}
+		}
+	}
+
+	return operation
+}
+
+// jsonContent membuat content dengan $ref ke schema struct
+func jsonContent(contentType string, value any) map[string]MediaType {
+	name := validation.SchemaName(reflect.TypeOf(value))
+	return map[string]MediaType{contentType: {Schema: &validation.Schema{Ref: SchemaRef + name}}}
+}
+
+// pathParameters mengambil parameter dari path, contoh /users/{id} menghasilkan parameter id
+func pathParameters(path string) []Parameter {
+	var parameters []Parameter
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name := strings.TrimSuffix(strings.Trim(segment, "{}"), "...")
+			parameters = append(parameters, Parameter{Name: name, In: "path", Required: true, Schema: &validation.Schema{Type: "string"}})
+		}
+	}
+
+	return parameters
+}
+
+// describe menambahkan deskripsi dari komentar dan contoh dari tag example ke schema setiap struct
+func (s *Spec) describe(schemas map[string]*validation.Schema, values []any) {
+	seen := map[reflect.Type]bool{}
+	for _, value := range values {
+		s.describeType(schemas, indirectType(reflect.TypeOf(value)), seen)
+	}
+}
+
+func (s *Spec) describeType(schemas map[string]*validation.Schema, typ reflect.Type, seen map[reflect.Type]bool) {
+	if seen[typ] {
+		return
+	}
+	seen[typ] = true
+
+	schema, ok := schemas[validation.SchemaName(typ)]
+	if !ok {
+		return
+	}
+	schema.Description = s.comments[typ.String()]
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if nested := elementStruct(field.Type); nested != nil {
+			s.describeType(schemas, nested, seen)
+		}
+
+		property, ok := schema.Properties[validation.FieldName(field)]
+		if !ok {
+			continue
+		}
+		if property.Ref == "" {
+			property.Description = s.comments[typ.String()+"."+field.Name]
+		}
+		if example, ok := field.Tag.Lookup(ExampleTagKey); ok {
+			property.Examples = []any{exampleValue(field.Type, example)}
+		}
+	}
+}
+
+// exampleValue mengubah isi tag example menjadi nilai JSON, contoh 1000 menjadi angka dan ["a"] menjadi array
+// field string dan isi tag yang bukan JSON valid dipakai sebagai string
+func exampleValue(typ reflect.Type, example string) any {
+	if indirectType(typ).Kind() == reflect.String {
+		return example
+	}
+
+	var value any
+	if err := json.Unmarshal([]byte(example), &value); err != nil {
+		return example
+	}
+
+	return value
+}
+
+// indirectType mengambil tipe di balik pointer
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
+
+// elementStruct mengambil struct di dalam tipe field, contoh []Address menghasilkan Address
+func elementStruct(typ reflect.Type) reflect.Type {
+	for {
+		switch typ.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			typ = typ.Elem()
+		case reflect.Struct:
+			return typ
+		default:
+			return nil
+		}
+	}
+}
+
+// WriteYAML menulis dokumen OpenAPI dalam format YAML dengan urutan key yang stabil
+func (s *Spec) WriteYAML(w io.Writer) error {
+	document, err := s.Document()
+	if err != nil {
+		return err
+	}
+
+	// dokumen di encode ke JSON dulu agar tag json dan extension x- di validation.Schema tetap dipakai,
+	// lalu dibaca sebagai yaml.Node agar urutan key sama dengan urutan field
+	data, err := json.Marshal(document)
+	if err != nil {
+		return err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(data, &node); err != nil {
+		return err
+	}
+	blockStyle(&node)
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(&node); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
+
+// blockStyle mengubah node hasil parsing JSON (flow style) menjadi block style
+func blockStyle(node *yaml.Node) {
+	node.Style &^= yaml.FlowStyle
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!str" {
+		node.Style &^= yaml.DoubleQuotedStyle
+	}
+	for _, child := range node.Content {
+		blockStyle(child)
+	}
+}
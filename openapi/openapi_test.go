@@ -0,0 +1,101 @@
+package openapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/problem"
+	"belajar-go-lang-validation/validation"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestDocument(t *testing.T) {
+	spec := New(validation.Default(), Info{Title: "Test", Version: "1.0.0"})
+	if err := spec.LoadComments("../model"); err != nil {
+		t.Fatal(err)
+	}
+
+	mux := http.NewServeMux()
+	spec.Handle(mux, "POST /login", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), Route{OperationID: "login", Request: model.LoginRequest{}})
+	spec.Register("PUT /users/{id}", Route{Request: model.User{}, Response: model.User{}})
+
+	// handler tetap di register ke mux
+	recorder := httptest.NewRecorder()
+	mux.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/login", nil))
+	if recorder.Code != http.StatusNoContent {
+		t.Errorf("expected handler to be registered, got %d", recorder.Code)
+	}
+
+	document, err := spec.Document()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	login := document.Paths["/login"]["post"]
+	if login.OperationID != "login" || login.RequestBody.Content["application/json"].Schema.Ref != SchemaRef+"model.LoginRequest" {
+		t.Errorf("unexpected login operation: %+v", login)
+	}
+	for _, status := range []string{"422", "500", "503"} {
+		if response, ok := login.Responses[status]; !ok || response.Content[problem.ContentType].Schema == nil {
+			t.Errorf("expected %s problem response, got %v", status, login.Responses)
+		}
+	}
+
+	user := document.Paths["/users/{id}"]["put"]
+	if len(user.Parameters) != 1 || user.Parameters[0].Name != "id" || user.Responses["200"].Content == nil {
+		t.Errorf("unexpected user operation: %+v", user)
+	}
+
+	schemas := document.Components.Schemas
+	for _, name := range []string{"model.LoginRequest", "model.User", "model.Address", "model.School", ProblemSchema, "problem.InvalidParam"} {
+		if schemas[name] == nil {
+			t.Errorf("expected schema %s", name)
+		}
+	}
+	if !strings.HasPrefix(schemas["model.LoginRequest"].Description, "LoginRequest digunakan untuk proses login") {
+		t.Errorf("unexpected description: %q", schemas["model.LoginRequest"].Description)
+	}
+	if examples := schemas["model.User"].Properties["id"].Examples; len(examples) != 1 || examples[0] != "1" {
+		t.Errorf("unexpected id examples: %v", examples)
+	}
+	if examples := schemas["model.Address"].Properties["city"].Examples; len(examples) != 1 || examples[0] != "Malang" {
+		t.Errorf("unexpected city examples: %v", examples)
+	}
+}
+
+func TestDocumentInvalidPattern(t *testing.T) {
+	spec := New(validation.Default(), Info{Title: "Test", Version: "1.0.0"})
+	spec.Register("/login", Route{Request: model.LoginRequest{}})
+
+	if _, err := spec.Document(); err == nil {
+		t.Error("expected error for pattern without method")
+	}
+}
+
+func TestWriteYAML(t *testing.T) {
+	spec := New(validation.Default(), Info{Title: "Test", Version: "1.0.0"})
+	spec.Register("POST /register", Route{Request: model.RegisterUser{}})
+
+	var buffer bytes.Buffer
+	if err := spec.WriteYAML(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	var document map[string]any
+	if err := yaml.Unmarshal(buffer.Bytes(), &document); err != nil {
+		t.Fatal(err)
+	}
+	if document["openapi"] != Version || !strings.HasPrefix(buffer.String(), "openapi: 3.1.0\n") {
+		t.Errorf("unexpected document:\n%s", buffer.String())
+	}
+	if !strings.Contains(buffer.String(), "x-eqfield: password") {
+		t.Errorf("expected eqfield extension:\n%s", buffer.String())
+	}
+}
@@ -0,0 +1,230 @@
+// package problem menyediakan helper net/http untuk decode dan validasi request body,
+// jika gagal akan menulis response application/problem+json sesuai RFC 9457
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+
+	"belajar-go-lang-validation/validation"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ContentType adalah media type untuk problem details
+const ContentType = "application/problem+json"
+
+// MaxBodyBytes adalah batas ukuran request body yang akan di decode
+const MaxBodyBytes = 1 << 20
+
+// type problem yang digunakan, about:blank berarti arti problem sama dengan status code nya
+const (
+	TypeBlank           = "about:blank"
+	TypeValidationError = "/problems/validation-error"
+)
+
+// judul problem validasi sesuai locale
+var validationTitles = map[string]string{
+	validation.LocaleEN: "Your request parameters didn't validate.",
+	validation.LocaleID: "Parameter request tidak valid.",
+}
+
+// InvalidParam adalah satu item di extension invalid-params
+type InvalidParam struct {
+	Name   string `json:"name"`
+	Reason string `json:"reason"`
+	Tag    string `json:"tag"`
+}
+
+// Details adalah isi response problem+json, Truncated bernilai true jika invalid-params tidak lengkap
+// karena dibatasi validation.WithErrorLimit
+type Details struct {
+	Type          string         `json:"type"`
+	Title         string         `json:"title"`
+	Status        int            `json:"status"`
+	Detail        string         `json:"detail,omitempty"`
+	Instance      string         `json:"instance,omitempty"`
+	InvalidParams []InvalidParam `json:"invalid-params,omitempty"`
+	Truncated     bool           `json:"truncated,omitempty"`
+}
+
+// Write menulis problem details ke response dengan status sesuai details.Status
+func Write(w http.ResponseWriter, details Details) {
+	if details.Type == "" {
+		details.Type = TypeBlank
+	}
+	if details.Title == "" {
+		details.Title = http.StatusText(details.Status)
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.WriteHeader(details.Status)
+	_ = json.NewEncoder(w).Encode(details)
+}
+
+// FromValidationError membuat problem details 422 dari error hasil validasi
+// pesan invalid-params di translate sesuai header Accept-Language
+func FromValidationError(validate *validation.Validator, r *http.Request, err error) Details {
+	locales := validation.LocalesFromAcceptLanguage(r.Header.Get("Accept-Language"))
+	trans := validate.Translator(locales...)
+
+	fieldErrors := validate.FieldErrors(err, trans.Locale())
+	params := make([]InvalidParam, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		params = append(params, InvalidParam{
+			Name:   fieldError.Path,
+			Reason: fieldError.Message,
+			Tag:    fieldError.Tag,
+		})
+	}
+
+	title, ok := validationTitles[trans.Locale()]
+	if !ok {
+		title = validationTitles[validation.LocaleEN]
+	}
+
+	return Details{
+		Type:          TypeValidationError,
+		Title:         title,
+		Status:        http.StatusUnprocessableEntity,
+		Instance:      r.URL.Path,
+		InvalidParams: params,
+		Truncated:     validation.Truncated(err),
+	}
+}
+
+// FromError membuat problem details dari error hasil validasi: 422 untuk validator.ValidationErrors,
+// 503 jika pengecekan ke repository (tag available) gagal dan 500 untuk error lain, contoh *validation.ConfigError
+func FromError(validate *validation.Validator, r *http.Request, err error) Details {
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		return FromValidationError(validate, r, err)
+	}
+
+	var lookupError *validation.LookupError
+	if errors.As(err, &lookupError) {
+		return Details{Status: http.StatusServiceUnavailable, Detail: "validation lookup is unavailable, try again later", Instance: r.URL.Path}
+	}
+
+	return Details{Status: http.StatusInternalServerError, Instance: r.URL.Path}
+}
+
+// Bind melakukan decode JSON request body ke T, menjalankan modifier dari tag mod lalu memvalidasinya
+// jika gagal, response problem+json sudah ditulis dan ok bernilai false, handler cukup return
+func Bind[T any](validate *validation.Validator, w http.ResponseWriter, r *http.Request) (value T, ok bool) {
+	if details, failed := decode(w, r, &value); failed {
+		details.Instance = r.URL.Path
+		Write(w, details)
+		return value, false
+	}
+
+	if err := validate.TransformStructCtx(r.Context(), &value); err != nil {
+		Write(w, FromError(validate, r, err))
+		return value, false
+	}
+
+	return value, true
+}
+
+// BindPatch sama dengan Bind untuk request PATCH, hanya field yang dikirim client yang divalidasi
+// presence berisi field yang dikirim, digunakan handler untuk menentukan field mana yang perlu di update
+func BindPatch[T any](validate *validation.Validator, w http.ResponseWriter, r *http.Request) (value T, presence validation.Presence, ok bool) {
+	var body json.RawMessage
+	if details, failed := decode(w, r, &body); failed {
+		details.Instance = r.URL.Path
+		Write(w, details)
+		return value, nil, false
+	}
+
+	presence, err := validation.DecodePartial(body, &value)
+	if err != nil {
+		Write(w, Details{Status: http.StatusBadRequest, Detail: "request body is not valid JSON: " + err.Error(), Instance: r.URL.Path})
+		return value, nil, false
+	}
+
+	if err := validate.TransformPatchCtx(r.Context(), &value, presence); err != nil {
+		Write(w, FromError(validate, r, err))
+		return value, nil, false
+	}
+
+	return value, presence, true
+}
+
+type contextKey struct{}
+
+// Middleware melakukan Bind sebelum next dipanggil, value hasil decode bisa diambil dengan Value
+func Middleware[T any](validate *validation.Validator) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			value, ok := Bind[T](validate, w, r)
+			if !ok {
+				return
+			}
+
+			ctx := context.WithValue(r.Context(), contextKey{}, value)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
+
+// Value mengambil value yang sudah di decode dan divalidasi oleh Middleware
+func Value[T any](r *http.Request) (T, bool) {
+	value, ok := r.Context().Value(contextKey{}).(T)
+	return value, ok
+}
+
+var errTrailingData = errors.New("body must contain a single JSON value")
+
+// trailingData memastikan tidak ada data lain setelah value JSON, contoh {"a":1}{"b":2}
+func trailingData(decoder *json.Decoder) error {
+	_, err := decoder.Token()
+	switch {
+	case errors.Is(err, io.EOF):
+		return nil
+	case err == nil:
+		return errTrailingData
+	}
+
+	return err
+}
+
+// decode membaca request body JSON, failed bernilai true jika body tidak bisa di decode
+// w diteruskan ke http.MaxBytesReader agar server menutup koneksi jika body terlalu besar
+func decode(w http.ResponseWriter, r *http.Request, target any) (details Details, failed bool) {
+	if contentType := r.Header.Get("Content-Type"); contentType != "" {
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType != "application/json" {
+			return Details{
+				Status: http.StatusUnsupportedMediaType,
+				Detail: fmt.Sprintf("content type %q is not supported, use application/json", contentType),
+			}, true
+		}
+	}
+
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, MaxBodyBytes))
+	err := decoder.Decode(target)
+	if err == nil {
+		err = trailingData(decoder)
+	}
+	if err != nil {
+		var maxBytesError *http.MaxBytesError
+		if errors.As(err, &maxBytesError) {
+			return Details{
+				Status: http.StatusRequestEntityTooLarge,
+				Detail: fmt.Sprintf("request body must not be larger than %d bytes", maxBytesError.Limit),
+			}, true
+		}
+
+		return Details{
+			Status: http.StatusBadRequest,
+			Detail: "request body is not valid JSON: " + err.Error(),
+		}, true
+	}
+
+	return Details{}, false
+}
@@ -0,0 +1,172 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/validation"
+)
+
+func serve(t *testing.T, body, contentType, language string) (*httptest.ResponseRecorder, Details) {
+	t.Helper()
+
+	handler := Middleware[model.RegisterUser](validation.Default())(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, ok := Value[model.RegisterUser](r)
+		if !ok {
+			t.Error("value not found in context")
+		}
+		w.Write([]byte(user.Username))
+	}))
+
+	request := httptest.NewRequest(http.MethodPost, "/register", strings.NewReader(body))
+	request.Header.Set("Content-Type", contentType)
+	request.Header.Set("Accept-Language", language)
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, request)
+
+	var details Details
+	if recorder.Header().Get("Content-Type") == ContentType {
+		if err := json.Unmarshal(recorder.Body.Bytes(), &details); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return recorder, details
+}
+
+func TestMiddlewareSuccess(t *testing.T) {
+	recorder, _ := serve(t, `{"username":"taufik@gmail.com","password":"Rahasia#Kuat9","confirm_password":"Rahasia#Kuat9"}`, "application/json", "")
+
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "taufik@gmail.com" {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestMiddlewareTransform(t *testing.T) {
+	// tag mod dijalankan sebelum validasi, spasi dan huruf besar di email tidak lagi membuat request gagal
+	recorder, _ := serve(t, `{"username":"  Taufik@Gmail.com ","password":"Rahasia#Kuat9","confirm_password":"Rahasia#Kuat9"}`, "application/json", "")
+
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "taufik@gmail.com" {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestMiddlewareValidationFailed(t *testing.T) {
+	recorder, details := serve(t, `{"username":"taufik","password":"Rahasia#Kuat9","confirm_password":"secret123"}`, "application/json", "id-ID,id;q=0.9")
+
+	if recorder.Code != http.StatusUnprocessableEntity || details.Type != TypeValidationError || details.Instance != "/register" {
+		t.Fatalf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+	if len(details.InvalidParams) != 2 {
+		t.Fatalf("expected 2 invalid params, got %+v", details.InvalidParams)
+	}
+	if details.InvalidParams[1].Name != "confirm_password" || details.InvalidParams[1].Tag != "eqfield" {
+		t.Errorf("unexpected invalid param %+v", details.InvalidParams[1])
+	}
+	if details.Title != validationTitles[validation.LocaleID] {
+		t.Errorf("expected indonesian title, got %q", details.Title)
+	}
+}
+
+func TestMiddlewareBadRequest(t *testing.T) {
+	recorder, details := serve(t, `{"username":`, "application/json", "")
+	if recorder.Code != http.StatusBadRequest || details.Status != http.StatusBadRequest || details.Type != TypeBlank {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+
+	recorder, _ = serve(t, `username=taufik`, "application/x-www-form-urlencoded", "")
+	if recorder.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+
+	// body hanya boleh berisi satu value JSON, whitespace di akhir tetap diterima
+	valid := `{"username":"taufik@gmail.com","password":"Rahasia#Kuat9","confirm_password":"Rahasia#Kuat9"}`
+	for _, body := range []string{valid + `{"username":"lain"}`, valid + ` x`} {
+		recorder, details := serve(t, body, "application/json", "")
+		if recorder.Code != http.StatusBadRequest || details.Status != http.StatusBadRequest {
+			t.Errorf("%s: unexpected response %d %s", body, recorder.Code, recorder.Body)
+		}
+	}
+	if recorder, _ := serve(t, valid+"\n ", "application/json", ""); recorder.Code != http.StatusOK {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+
+	recorder, _ = serve(t, `{"username":"`+strings.Repeat("a", MaxBodyBytes)+`"}`, "application/json", "")
+	if recorder.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("unexpected response %d", recorder.Code)
+	}
+}
+
+func TestBindPatch(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user, presence, ok := BindPatch[model.User](validation.Default(), w, r)
+		if !ok {
+			return
+		}
+		if presence.Has("/name") {
+			w.Write([]byte(user.Name))
+		}
+	})
+
+	patch := func(body string) (*httptest.ResponseRecorder, Details) {
+		request := httptest.NewRequest(http.MethodPatch, "/users/1", strings.NewReader(body))
+		request.Header.Set("Content-Type", "application/json")
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, request)
+
+		var details Details
+		if recorder.Header().Get("Content-Type") == ContentType {
+			if err := json.Unmarshal(recorder.Body.Bytes(), &details); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return recorder, details
+	}
+
+	// field required lain tidak dikirim, hanya name yang divalidasi dan dirapikan oleh tag mod
+	recorder, _ := patch(`{"name": "  Budi   Santoso "}`)
+	if recorder.Code != http.StatusOK || recorder.Body.String() != "Budi Santoso" {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+
+	recorder, details := patch(`{"address": [{"city": ""}]}`)
+	if recorder.Code != http.StatusUnprocessableEntity || len(details.InvalidParams) != 1 || details.InvalidParams[0].Name != "address[0].city" {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+
+	recorder, _ = patch(`{"name": 1}`)
+	if recorder.Code != http.StatusBadRequest {
+		t.Errorf("unexpected response %d %s", recorder.Code, recorder.Body)
+	}
+}
+
+func TestFromError(t *testing.T) {
+	request := httptest.NewRequest(http.MethodPost, "/register", nil)
+	validate := validation.Default()
+
+	lookupError := fmt.Errorf("check: %w", &validation.LookupError{Kind: "email", Err: context.DeadlineExceeded})
+	if details := FromError(validate, request, lookupError); details.Status != http.StatusServiceUnavailable || details.Instance != "/register" {
+		t.Errorf("unexpected details %+v", details)
+	}
+
+	if details := FromError(validate, request, &validation.ConfigError{}); details.Status != http.StatusInternalServerError {
+		t.Errorf("unexpected details %+v", details)
+	}
+
+	err := validate.Struct(model.LoginRequest{})
+	if details := FromError(validate, request, err); details.Status != http.StatusUnprocessableEntity || len(details.InvalidParams) != 2 || details.Truncated {
+		t.Errorf("unexpected details %+v", details)
+	}
+
+	err = validate.StructCtx(validation.WithErrorLimit(request.Context(), validation.FailFast), model.LoginRequest{})
+	if details := FromError(validate, request, err); len(details.InvalidParams) != 1 || !details.Truncated {
+		t.Errorf("unexpected details %+v", details)
+	}
+}
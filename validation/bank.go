@@ -0,0 +1,122 @@
+package validation
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// tag untuk validasi kode bank dan nomor rekening
+const (
+	TagBankCode    = "bank_code"
+	TagBankAccount = "bank_account"
+)
+
+//go:embed data/banks.csv
+var banksCSV []byte
+
+// Bank adalah data satu bank beserta aturan panjang nomor rekeningnya
+type Bank struct {
+	Code      string
+	Name      string
+	Clearing  string
+	MinLength int
+	MaxLength int
+}
+
+var banks = mustLoadBanks(banksCSV)
+
+// loadBanks membaca daftar bank dengan format "kode,nama,kliring,min,max", baris diawali '#' adalah komentar
+func loadBanks(reader io.Reader) (map[string]Bank, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = 5
+
+	result := map[string]Bank{}
+	for {
+		record, err := csvReader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		minLength, minErr := strconv.Atoi(record[3])
+		maxLength, maxErr := strconv.Atoi(record[4])
+		if minErr != nil || maxErr != nil || minLength <= 0 || minLength > maxLength {
+			return nil, fmt.Errorf("banks: invalid account length for %s", record[0])
+		}
+
+		result[record[0]] = Bank{
+			Code:      record[0],
+			Name:      record[1],
+			Clearing:  record[2],
+			MinLength: minLength,
+			MaxLength: maxLength,
+		}
+	}
+
+	return result, nil
+}
+
+func mustLoadBanks(data []byte) map[string]Bank {
+	result, err := loadBanks(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+
+// LookupBank mencari bank berdasarkan kode, contoh BCA
+func LookupBank(code string) (Bank, bool) {
+	bank, ok := banks[code]
+	return bank, ok
+}
+
+// Banks mengembalikan seluruh bank yang didukung, diurutkan berdasarkan kode
+func Banks() []Bank {
+	result := make([]Bank, 0, len(banks))
+	for _, bank := range banks {
+		result = append(result, bank)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Code < result[j].Code
+	})
+
+	return result
+}
+
+// ValidAccount mengecek nomor rekening hanya berisi angka dengan panjang sesuai aturan bank
+func (b Bank) ValidAccount(number string) bool {
+	return regexNumber.MatchString(number) && len(number) >= b.MinLength && len(number) <= b.MaxLength
+}
+
+// MustValidBankCode memastikan value adalah kode bank yang terdaftar, biasa dipakai di dalam keys ... endkeys
+func MustValidBankCode(field validator.FieldLevel) bool {
+	value, ok := field.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+
+	_, ok = LookupBank(value)
+	return ok
+}
+
+// MustValidBankAccount memastikan value adalah nomor rekening bank sesuai parameter tag, contoh bank_account=BCA
+func MustValidBankAccount(field validator.FieldLevel) bool {
+	value, ok := field.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+
+	bank, ok := LookupBank(field.Param())
+	return ok && bank.ValidAccount(value)
+}
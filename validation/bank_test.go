@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"testing"
+
+	"belajar-go-lang-validation/model"
+)
+
+func TestBankAccount(t *testing.T) {
+	type Transfer struct {
+		Bca     string `json:"bca" validate:"required,bank_account=BCA"`
+		Mandiri string `json:"mandiri" validate:"required,bank_account=MANDIRI"`
+	}
+
+	if err := Default().Struct(Transfer{Bca: "1234567890", Mandiri: "1234567890123"}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	err := Default().Struct(Transfer{Bca: "123456789", Mandiri: "12345678901a3"})
+	fieldErrors := Default().FieldErrors(err, LocaleEN)
+	if len(fieldErrors) != 2 {
+		t.Fatalf("expected 2 errors, got %+v", fieldErrors)
+	}
+	if fieldErrors[0].Message != "bca must be a valid BCA account number" {
+		t.Errorf("unexpected message %q", fieldErrors[0].Message)
+	}
+}
+
+func TestBankCodeKeys(t *testing.T) {
+	user := model.User{
+		Id:      "1",
+		Name:    "Taufik",
+		Address: []model.Address{{City: "Banyuwangi", Country: "Indonesia"}},
+		Hobbies: []string{"Reading"},
+		Wallets: map[string]int{"BNI": 100000, "BCA": 500000, "JAGO": 5000},
+	}
+
+	fieldErrors := Default().FieldErrors(Default().Struct(user))
+	if len(fieldErrors) != 1 || fieldErrors[0].Tag != TagBankCode || fieldErrors[0].Path != `wallets["JAGO"]` {
+		t.Errorf("unexpected errors %+v", fieldErrors)
+	}
+
+	if bank, ok := LookupBank("BRI"); !ok || bank.Clearing != "002" {
+		t.Errorf("unexpected bank %+v", bank)
+	}
+	if banks := Banks(); banks[0].Code != "BCA" {
+		t.Errorf("banks must be sorted, got %s first", banks[0].Code)
+	}
+}
@@ -0,0 +1,184 @@
+package validation
+
+// builtinTags adalah tag bawaan validator v10.30.1 (fungsi validasi dan alias), validator package tidak menyediakan
+// daftar ini sehingga perlu diperbarui saat versi validator dinaikkan
+var builtinTags = map[string]bool{
+	"iscolor":                       true,
+	"country_code":                  true,
+	"eu_country_code":               true,
+	"required":                      true,
+	"required_if":                   true,
+	"required_unless":               true,
+	"skip_unless":                   true,
+	"required_with":                 true,
+	"required_with_all":             true,
+	"required_without":              true,
+	"required_without_all":          true,
+	"excluded_if":                   true,
+	"excluded_unless":               true,
+	"excluded_with":                 true,
+	"excluded_with_all":             true,
+	"excluded_without":              true,
+	"excluded_without_all":          true,
+	"isdefault":                     true,
+	"len":                           true,
+	"min":                           true,
+	"max":                           true,
+	"eq":                            true,
+	"eq_ignore_case":                true,
+	"ne":                            true,
+	"ne_ignore_case":                true,
+	"lt":                            true,
+	"lte":                           true,
+	"gt":                            true,
+	"gte":                           true,
+	"eqfield":                       true,
+	"eqcsfield":                     true,
+	"necsfield":                     true,
+	"gtcsfield":                     true,
+	"gtecsfield":                    true,
+	"ltcsfield":                     true,
+	"ltecsfield":                    true,
+	"nefield":                       true,
+	"gtefield":                      true,
+	"gtfield":                       true,
+	"ltefield":                      true,
+	"ltfield":                       true,
+	"fieldcontains":                 true,
+	"fieldexcludes":                 true,
+	"alpha":                         true,
+	"alphaspace":                    true,
+	"alphanum":                      true,
+	"alphanumspace":                 true,
+	"alphaunicode":                  true,
+	"alphanumunicode":               true,
+	"boolean":                       true,
+	"numeric":                       true,
+	"number":                        true,
+	"hexadecimal":                   true,
+	"hexcolor":                      true,
+	"rgb":                           true,
+	"rgba":                          true,
+	"hsl":                           true,
+	"hsla":                          true,
+	"e164":                          true,
+	"email":                         true,
+	"url":                           true,
+	"http_url":                      true,
+	"https_url":                     true,
+	"uri":                           true,
+	"urn_rfc2141":                   true,
+	"file":                          true,
+	"filepath":                      true,
+	"base32":                        true,
+	"base64":                        true,
+	"base64url":                     true,
+	"base64rawurl":                  true,
+	"contains":                      true,
+	"containsany":                   true,
+	"containsrune":                  true,
+	"excludes":                      true,
+	"excludesall":                   true,
+	"excludesrune":                  true,
+	"startswith":                    true,
+	"endswith":                      true,
+	"startsnotwith":                 true,
+	"endsnotwith":                   true,
+	"image":                         true,
+	"isbn":                          true,
+	"isbn10":                        true,
+	"isbn13":                        true,
+	"issn":                          true,
+	"eth_addr":                      true,
+	"eth_addr_checksum":             true,
+	"btc_addr":                      true,
+	"btc_addr_bech32":               true,
+	"uuid":                          true,
+	"uuid3":                         true,
+	"uuid4":                         true,
+	"uuid5":                         true,
+	"uuid_rfc4122":                  true,
+	"uuid3_rfc4122":                 true,
+	"uuid4_rfc4122":                 true,
+	"uuid5_rfc4122":                 true,
+	"ulid":                          true,
+	"md4":                           true,
+	"md5":                           true,
+	"sha256":                        true,
+	"sha384":                        true,
+	"sha512":                        true,
+	"ripemd128":                     true,
+	"ripemd160":                     true,
+	"tiger128":                      true,
+	"tiger160":                      true,
+	"tiger192":                      true,
+	"ascii":                         true,
+	"printascii":                    true,
+	"multibyte":                     true,
+	"datauri":                       true,
+	"latitude":                      true,
+	"longitude":                     true,
+	"ssn":                           true,
+	"ipv4":                          true,
+	"ipv6":                          true,
+	"ip":                            true,
+	"cidrv4":                        true,
+	"cidrv6":                        true,
+	"cidr":                          true,
+	"tcp4_addr":                     true,
+	"tcp6_addr":                     true,
+	"tcp_addr":                      true,
+	"udp4_addr":                     true,
+	"udp6_addr":                     true,
+	"udp_addr":                      true,
+	"ip4_addr":                      true,
+	"ip6_addr":                      true,
+	"ip_addr":                       true,
+	"unix_addr":                     true,
+	"uds_exists":                    true,
+	"mac":                           true,
+	"hostname":                      true,
+	"hostname_rfc1123":              true,
+	"fqdn":                          true,
+	"unique":                        true,
+	"oneof":                         true,
+	"oneofci":                       true,
+	"html":                          true,
+	"html_encoded":                  true,
+	"url_encoded":                   true,
+	"dir":                           true,
+	"dirpath":                       true,
+	"json":                          true,
+	"jwt":                           true,
+	"hostname_port":                 true,
+	"port":                          true,
+	"lowercase":                     true,
+	"uppercase":                     true,
+	"datetime":                      true,
+	"timezone":                      true,
+	"iso3166_1_alpha2":              true,
+	"iso3166_1_alpha2_eu":           true,
+	"iso3166_1_alpha3":              true,
+	"iso3166_1_alpha3_eu":           true,
+	"iso3166_1_alpha_numeric":       true,
+	"iso3166_1_alpha_numeric_eu":    true,
+	"iso3166_2":                     true,
+	"iso4217":                       true,
+	"iso4217_numeric":               true,
+	"bcp47_language_tag":            true,
+	"postcode_iso3166_alpha2":       true,
+	"postcode_iso3166_alpha2_field": true,
+	"bic_iso_9362_2014":             true,
+	"bic":                           true,
+	"semver":                        true,
+	"dns_rfc1035_label":             true,
+	"credit_card":                   true,
+	"cve":                           true,
+	"luhn_checksum":                 true,
+	"mongodb":                       true,
+	"mongodb_connection_string":     true,
+	"cron":                          true,
+	"spicedb":                       true,
+	"ein":                           true,
+	"validateFn":                    true,
+}
@@ -0,0 +1,526 @@
+package validation
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// format input dan report Bulk
+const (
+	BulkCSV    = "csv"
+	BulkNDJSON = "ndjson"
+)
+
+// CSVTagKey adalah tag untuk nama kolom CSV, jika tidak ada nama kolom sama dengan nama field di JSON
+const CSVTagKey = "csv"
+
+// TagParse adalah tag di report Bulk jika isi kolom CSV tidak bisa diubah ke tipe field, parameternya adalah
+// tipe yang diharapkan, contoh integer
+const TagParse = "parse"
+
+// TagDecode adalah tag di report Bulk jika satu baris tidak bisa dibaca, contoh JSON tidak valid atau jumlah kolom salah
+const TagDecode = "decode"
+
+// DefaultBulkSeparator adalah pemisah value slice di satu kolom CSV, contoh Coding;Reading untuk hobbies
+const DefaultBulkSeparator = ";"
+
+// BulkOptions mengatur Bulk
+type BulkOptions struct {
+	// Input adalah format input, BulkCSV (default) atau BulkNDJSON
+	Input string
+	// Report adalah format report, BulkCSV (default) atau BulkNDJSON
+	Report string
+	// Locale adalah bahasa pesan error di report
+	Locale string
+	// Comma adalah pemisah kolom CSV, default ','
+	Comma rune
+	// Separator adalah pemisah value slice di satu kolom CSV, default DefaultBulkSeparator
+	Separator string
+	// Strict membuat kolom CSV atau field JSON yang tidak dikenal menjadi error
+	Strict bool
+}
+
+// RowError adalah satu baris report Bulk, Row adalah nomor baris di file (header CSV adalah baris 1)
+// Column adalah nama kolom CSV atau path field, kosong jika baris tidak bisa dibaca
+type RowError struct {
+	Row     int    `json:"row"`
+	Column  string `json:"column"`
+	Tag     string `json:"tag"`
+	Message string `json:"message"`
+}
+
+// BulkSummary adalah ringkasan hasil Bulk
+type BulkSummary struct {
+	Rows    int `json:"rows"`
+	Invalid int `json:"invalid"`
+	Errors  int `json:"errors"`
+}
+
+// Bulk memvalidasi setiap baris CSV atau NDJSON dari r sebagai tipe row (contoh model.Address{}) dengan rule yang
+// sama dengan Struct, termasuk modifier dari tag mod, lalu menulis satu baris report untuk setiap error ke w
+// baris dibaca dan ditulis satu per satu, sehingga memory yang digunakan tidak bergantung pada ukuran file
+//
+// kolom CSV dicocokkan dengan tag csv atau nama field di JSON tanpa membedakan huruf besar kecil, field struct di
+// dalamnya menggunakan titik, contoh address.city. Error selain kesalahan input (IO, header CSV yang tidak bisa
+// dipetakan, *ConfigError atau *LookupError) menghentikan Bulk, report baris sebelumnya tetap ditulis ke w
+func (v *Validator) Bulk(ctx context.Context, r io.Reader, w io.Writer, row any, opts BulkOptions) (summary BulkSummary, err error) {
+	typ := reflect.TypeOf(row)
+	if typ == nil || indirectType(typ).Kind() != reflect.Struct {
+		return BulkSummary{}, fmt.Errorf("validation: bulk row must be a struct, got %v", typ)
+	}
+	if err := v.checkType(typ); err != nil {
+		return BulkSummary{}, err
+	}
+	if opts.Separator == "" {
+		opts.Separator = DefaultBulkSeparator
+	}
+
+	report, err := newRowReport(w, opts.Report)
+	if err != nil {
+		return BulkSummary{}, err
+	}
+	// report selalu di flush, termasuk saat ctx dibatalkan atau Bulk berhenti karena error di tengah input
+	defer func() {
+		if flushErr := report.flush(); flushErr != nil && !errors.Is(err, flushErr) {
+			err = errors.Join(err, flushErr)
+		}
+	}()
+
+	bulk := &bulkValidation{v: v, ctx: ctx, typ: indirectType(typ), opts: opts, report: report}
+	switch opts.Input {
+	case "", BulkCSV:
+		err = bulk.csv(r)
+	case BulkNDJSON:
+		err = bulk.ndjson(r)
+	default:
+		err = fmt.Errorf("validation: unknown bulk input %q, available: %s, %s", opts.Input, BulkCSV, BulkNDJSON)
+	}
+
+	return bulk.summary, err
+}
+
+// bulkValidation menyimpan state satu kali Bulk
+type bulkValidation struct {
+	v       *Validator
+	ctx     context.Context
+	typ     reflect.Type
+	opts    BulkOptions
+	report  *rowReport
+	summary BulkSummary
+	// columns adalah field untuk setiap kolom CSV (nil jika dilewati), headers adalah nama kolom untuk path field
+	columns []*bulkColumn
+	headers map[string]string
+}
+
+// bulkColumn adalah kolom CSV yang dipetakan ke field, path adalah namespace field tanpa root, contoh address.city
+type bulkColumn struct {
+	header string
+	index  []int
+	path   string
+	typ    reflect.Type
+}
+
+// csv membaca header lalu memvalidasi setiap record
+func (b *bulkValidation) csv(r io.Reader) error {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+	if b.opts.Comma != 0 {
+		reader.Comma = b.opts.Comma
+	}
+
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("validation: csv header: %w", err)
+	}
+
+	if err := b.mapColumns(header); err != nil {
+		return err
+	}
+
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if ctxErr := b.ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+
+		var parseError *csv.ParseError
+		if errors.As(err, &parseError) {
+			b.summary.Rows++
+			if err := b.write(RowError{Row: parseError.StartLine, Tag: TagDecode, Message: parseError.Err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		line, _ := reader.FieldPos(0)
+		if err := b.record(line, record); err != nil {
+			return err
+		}
+	}
+}
+
+// mapColumns memetakan header CSV ke field, kolom yang tidak dikenal dilewati kecuali Strict
+func (b *bulkValidation) mapColumns(header []string) error {
+	b.columns, b.headers = make([]*bulkColumn, len(header)), map[string]string{}
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		column, err := bulkField(b.typ, name)
+		if err != nil {
+			if errors.Is(err, errUnknownColumn) && !b.opts.Strict {
+				continue
+			}
+			return fmt.Errorf("validation: csv column %q: %w", name, err)
+		}
+		if previous, ok := b.headers[column.path]; ok {
+			return fmt.Errorf("validation: csv columns %q and %q map to the same field %s", previous, name, column.path)
+		}
+
+		b.headers[column.path] = name
+		b.columns[i] = column
+	}
+
+	return nil
+}
+
+var errUnknownColumn = errors.New("no matching field")
+
+// bulkField mencari field untuk nama kolom, contoh address.city menjadi field City di field Address
+func bulkField(typ reflect.Type, name string) (*bulkColumn, error) {
+	column := &bulkColumn{header: name}
+	var path []string
+	for _, part := range strings.Split(name, ".") {
+		typ = indirectType(typ)
+		if typ.Kind() != reflect.Struct || isTextUnmarshaler(typ) {
+			return nil, errUnknownColumn
+		}
+
+		field, ok := csvField(typ, part)
+		if !ok {
+			return nil, errUnknownColumn
+		}
+		column.index = append(column.index, field.Index...)
+		path = append(path, FieldName(field))
+		typ = field.Type
+	}
+
+	if !cellSupported(typ) {
+		return nil, fmt.Errorf("field type %s is not supported in csv", typ)
+	}
+	column.path, column.typ = strings.Join(path, "."), typ
+
+	return column, nil
+}
+
+// csvField mencari field dengan tag csv, jika tidak ada menggunakan aturan nama yang sama dengan encoding/json
+func csvField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if tag := field.Tag.Get(CSVTagKey); field.IsExported() && tag != "" && tag != "-" && strings.EqualFold(tag, name) {
+			return field, true
+		}
+	}
+
+	field, ok := jsonField(typ, name)
+	if !ok || field.Tag.Get(CSVTagKey) == "-" {
+		return reflect.StructField{}, false
+	}
+
+	return field, true
+}
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+func isTextUnmarshaler(typ reflect.Type) bool {
+	return reflect.PointerTo(typ).Implements(textUnmarshalerType)
+}
+
+// cellSupported mengecek tipe field bisa diisi dari satu kolom CSV
+func cellSupported(typ reflect.Type) bool {
+	if isTextUnmarshaler(typ) {
+		return true
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer:
+		return cellSupported(typ.Elem())
+	case reflect.Slice:
+		return typ.Elem().Kind() != reflect.Slice && cellSupported(typ.Elem())
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// cellType adalah parameter TagParse untuk tipe field
+func cellType(typ reflect.Type) string {
+	if isTextUnmarshaler(typ) {
+		return strings.ToLower(typ.Name())
+	}
+
+	switch typ.Kind() {
+	case reflect.Pointer, reflect.Slice:
+		return cellType(typ.Elem())
+	case reflect.Bool:
+		return "boolean"
+	case reflect.Float32, reflect.Float64:
+		return "number"
+	default:
+		return "integer"
+	}
+}
+
+// setCell mengisi field dari isi kolom, kolom kosong membuat field tetap bernilai zero
+func setCell(target reflect.Value, text, separator string) bool {
+	if text == "" {
+		return true
+	}
+	if isTextUnmarshaler(target.Type()) {
+		return target.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(text)) == nil
+	}
+
+	switch target.Kind() {
+	case reflect.Pointer:
+		value := reflect.New(target.Type().Elem())
+		if !setCell(value.Elem(), text, separator) {
+			return false
+		}
+		target.Set(value)
+	case reflect.Slice:
+		parts := strings.Split(text, separator)
+		slice := reflect.MakeSlice(target.Type(), len(parts), len(parts))
+		for i, part := range parts {
+			if !setCell(slice.Index(i), strings.TrimSpace(part), separator) {
+				return false
+			}
+		}
+		target.Set(slice)
+	case reflect.String:
+		target.SetString(text)
+	case reflect.Bool:
+		value, err := strconv.ParseBool(text)
+		if err != nil {
+			return false
+		}
+		target.SetBool(value)
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(text, target.Type().Bits())
+		if err != nil {
+			return false
+		}
+		target.SetFloat(value)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(text, 10, target.Type().Bits())
+		if err != nil {
+			return false
+		}
+		target.SetInt(value)
+	default:
+		value, err := strconv.ParseUint(text, 10, target.Type().Bits())
+		if err != nil {
+			return false
+		}
+		target.SetUint(value)
+	}
+
+	return true
+}
+
+// fieldByIndex sama dengan reflect.Value.FieldByIndex, pointer struct yang masih nil dibuat terlebih dahulu
+func fieldByIndex(value reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				value.Set(reflect.New(value.Type().Elem()))
+			}
+			value = value.Elem()
+		}
+		value = value.Field(x)
+	}
+
+	return value
+}
+
+// record mengisi struct baru dari satu record CSV lalu memvalidasinya
+func (b *bulkValidation) record(line int, record []string) error {
+	value := reflect.New(b.typ)
+	var rowErrors []RowError
+	failed := map[string]bool{}
+	for i, column := range b.columns {
+		if column == nil || i >= len(record) {
+			continue
+		}
+
+		// cell kosong tidak mengisi apapun, parent pointer tetap nil agar omitempty di struct tersebut tetap berlaku
+		text := strings.TrimSpace(record[i])
+		if text == "" {
+			continue
+		}
+		if !setCell(fieldByIndex(value.Elem(), column.index), text, b.opts.Separator) {
+			failed[column.path] = true
+			rowErrors = append(rowErrors, RowError{Row: line, Column: column.header, Tag: TagParse, Message: b.parseMessage(column)})
+		}
+	}
+
+	return b.validate(line, value, rowErrors, func(fieldError validator.FieldError) (string, bool) {
+		path := errorFields(fieldError)
+		if failed[path] {
+			return "", false
+		}
+		if header, ok := b.headers[path]; ok {
+			return header, true
+		}
+		return errorPath(fieldError), true
+	})
+}
+
+// parseMessage adalah pesan TagParse sesuai locale
+func (b *bulkValidation) parseMessage(column *bulkColumn) string {
+	message, err := b.v.Translator(b.opts.Locale).T(TagParse, column.header, cellType(column.typ))
+	if err != nil {
+		return fmt.Sprintf("%s must be a valid %s", column.header, cellType(column.typ))
+	}
+
+	return message
+}
+
+// ndjson membaca satu dokumen JSON per baris, baris kosong dilewati
+func (b *bulkValidation) ndjson(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if err := b.ctx.Err(); err != nil {
+			return err
+		}
+
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		value := reflect.New(b.typ)
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		if b.opts.Strict {
+			decoder.DisallowUnknownFields()
+		}
+		if err := decoder.Decode(value.Interface()); err != nil {
+			b.summary.Rows++
+			if err := b.write(RowError{Row: line, Tag: TagDecode, Message: err.Error()}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := b.validate(line, value, nil, func(fieldError validator.FieldError) (string, bool) {
+			return errorPath(fieldError), true
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+// validate menjalankan modifier dan validasi untuk satu baris, column mengembalikan nama kolom untuk error
+// atau false jika error dilewati (contoh kolom yang sudah gagal di parse)
+func (b *bulkValidation) validate(line int, value reflect.Value, rowErrors []RowError, column func(validator.FieldError) (string, bool)) error {
+	b.summary.Rows++
+
+	err := b.v.TransformStructCtx(b.ctx, value.Interface())
+	var validationErrors validator.ValidationErrors
+	if err != nil && !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	trans := b.v.Translator(b.opts.Locale)
+	for _, fieldError := range validationErrors {
+		name, ok := column(fieldError)
+		if !ok {
+			continue
+		}
+		rowErrors = append(rowErrors, RowError{Row: line, Column: name, Tag: fieldError.Tag(), Message: fieldError.Translate(trans)})
+	}
+
+	return b.write(rowErrors...)
+}
+
+// write menulis error satu baris ke report
+func (b *bulkValidation) write(rowErrors ...RowError) error {
+	if len(rowErrors) == 0 {
+		return nil
+	}
+
+	b.summary.Invalid++
+	b.summary.Errors += len(rowErrors)
+	for _, rowError := range rowErrors {
+		if err := b.report.write(rowError); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rowReport menulis RowError sebagai CSV (dengan header) atau NDJSON
+type rowReport struct {
+	csv    *csv.Writer
+	buffer *bufio.Writer
+	json   *json.Encoder
+}
+
+func newRowReport(w io.Writer, format string) (*rowReport, error) {
+	switch format {
+	case "", BulkCSV:
+		writer := csv.NewWriter(w)
+		if err := writer.Write([]string{"row", "column", "tag", "message"}); err != nil {
+			return nil, err
+		}
+		return &rowReport{csv: writer}, nil
+	case BulkNDJSON:
+		buffer := bufio.NewWriter(w)
+		return &rowReport{buffer: buffer, json: json.NewEncoder(buffer)}, nil
+	default:
+		return nil, fmt.Errorf("validation: unknown bulk report %q, available: %s, %s", format, BulkCSV, BulkNDJSON)
+	}
+}
+
+func (r *rowReport) write(rowError RowError) error {
+	if r.json != nil {
+		return r.json.Encode(rowError)
+	}
+
+	return r.csv.Write([]string{strconv.Itoa(rowError.Row), rowError.Column, rowError.Tag, rowError.Message})
+}
+
+func (r *rowReport) flush() error {
+	if r.buffer != nil {
+		return r.buffer.Flush()
+	}
+
+	r.csv.Flush()
+	return r.csv.Error()
+}
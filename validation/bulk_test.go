@@ -0,0 +1,218 @@
+package validation
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"belajar-go-lang-validation/model"
+)
+
+func TestBulkCSV(t *testing.T) {
+	input := "ID,nama,hobbies,catatan\n" +
+		"1,  Taufik  ,Coding;Reading,bebas\n" +
+		"2,,Coding;TV,\n" +
+		"\"3\",\"Budi\nSantoso\",,\n" +
+		"4,Ani\n"
+
+	type row struct {
+		Id      string   `json:"id" validate:"required"`
+		Name    string   `json:"name" csv:"nama" validate:"required" mod:"collapse"`
+		Hobbies []string `json:"hobbies" validate:"required,dive,min=3"`
+	}
+
+	var report bytes.Buffer
+	summary, err := Default().Bulk(context.Background(), strings.NewReader(input), &report, row{}, BulkOptions{Locale: LocaleID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary != (BulkSummary{Rows: 4, Invalid: 3, Errors: 4}) {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	records, err := csv.NewReader(&report).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := [][]string{
+		{"row", "column", "tag", "message"},
+		{"3", "nama", "required", "name wajib diisi"},
+		{"3", "hobbies", "min", "panjang minimal hobbies[1] adalah 3 karakter"},
+		{"4", "hobbies", "required", "hobbies wajib diisi"},
+		{"6", "", TagDecode, "wrong number of fields"},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, records)
+	}
+	for i := range expected {
+		if strings.Join(records[i], "|") != strings.Join(expected[i], "|") {
+			t.Errorf("row %d: expected %v, got %v", i, expected[i], records[i])
+		}
+	}
+}
+
+func TestBulkParseAndNested(t *testing.T) {
+	type row struct {
+		Age     int           `json:"age" validate:"gte=17"`
+		Active  *bool         `json:"active" validate:"required"`
+		Joined  time.Time     `json:"joined"`
+		Address model.Address `json:"address"`
+	}
+
+	input := "age,active,joined,address.city,address.country\n" +
+		"dua puluh,ya,kemarin,Malang,Indonesia\n" +
+		"20,true,2024-01-02T00:00:00Z,,Indonesia\n"
+
+	var report bytes.Buffer
+	summary, err := Default().Bulk(context.Background(), strings.NewReader(input), &report, row{}, BulkOptions{Report: BulkNDJSON})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary.Invalid != 2 || summary.Errors != 4 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	var rowErrors []RowError
+	decoder := json.NewDecoder(&report)
+	for decoder.More() {
+		var rowError RowError
+		if err := decoder.Decode(&rowError); err != nil {
+			t.Fatal(err)
+		}
+		rowErrors = append(rowErrors, rowError)
+	}
+
+	// kolom yang gagal di parse tidak divalidasi lagi, sehingga active tidak mendapat error required
+	expected := []RowError{
+		{Row: 2, Column: "age", Tag: TagParse, Message: "age must be a valid integer"},
+		{Row: 2, Column: "active", Tag: TagParse, Message: "active must be a valid boolean"},
+		{Row: 2, Column: "joined", Tag: TagParse, Message: "joined must be a valid time"},
+		{Row: 3, Column: "address.city", Tag: "required", Message: "city is a required field"},
+	}
+	if len(rowErrors) != len(expected) {
+		t.Fatalf("expected %+v, got %+v", expected, rowErrors)
+	}
+	for i := range expected {
+		if rowErrors[i] != expected[i] {
+			t.Errorf("expected %+v, got %+v", expected[i], rowErrors[i])
+		}
+	}
+}
+
+func TestBulkNDJSON(t *testing.T) {
+	input := `{"city": "Malang", "country": "Indonesia"}` + "\n\n" +
+		`{"city": " ", "country": "Indonesia"}` + "\n" +
+		`{bukan json` + "\n"
+
+	var report bytes.Buffer
+	summary, err := Default().Bulk(context.Background(), strings.NewReader(input), &report, &model.Address{}, BulkOptions{Input: BulkNDJSON})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if summary != (BulkSummary{Rows: 3, Invalid: 2, Errors: 2}) {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	// modifier collapse membuat city kosong
+	if !strings.Contains(report.String(), "3,city,required,city is a required field") || !strings.Contains(report.String(), "4,,decode,") {
+		t.Errorf("unexpected report\n%s", report.String())
+	}
+}
+
+func TestBulkErrors(t *testing.T) {
+	validate := Default()
+	tests := []struct {
+		input string
+		row   any
+		opts  BulkOptions
+	}{
+		{"city,kota\n", model.Address{}, BulkOptions{Strict: true}},
+		{"city,City\n", model.Address{}, BulkOptions{}},
+		{"id,address\n", model.User{}, BulkOptions{}},
+		{"city\n", model.Address{}, BulkOptions{Report: "xml"}},
+		{"city\n", "bukan struct", BulkOptions{}},
+	}
+
+	for _, test := range tests {
+		if _, err := validate.Bulk(context.Background(), strings.NewReader(test.input), &bytes.Buffer{}, test.row, test.opts); err == nil {
+			t.Errorf("%q: expected error", test.input)
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := validate.Bulk(ctx, strings.NewReader("city\nMalang\n"), &bytes.Buffer{}, model.Address{}, BulkOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled, got %v", err)
+	}
+}
+
+// cancelReader membatalkan ctx saat chunk kedua dibaca
+type cancelReader struct {
+	chunks []string
+	cancel context.CancelFunc
+	reads  int
+}
+
+func (r *cancelReader) Read(p []byte) (int, error) {
+	if r.reads == len(r.chunks) {
+		return 0, io.EOF
+	}
+	if r.reads == 1 {
+		r.cancel()
+	}
+	r.reads++
+
+	return copy(p, r.chunks[r.reads-1]), nil
+}
+
+func TestBulkFlushOnCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r := &cancelReader{chunks: []string{"city,country\nMalang,\n,Indonesia\n", "Bandung,\n"}, cancel: cancel}
+
+	var report bytes.Buffer
+	summary, err := Default().Bulk(ctx, r, &report, model.Address{}, BulkOptions{})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled, got %v", err)
+	}
+	if summary.Rows != 2 {
+		t.Errorf("unexpected summary %+v", summary)
+	}
+
+	// baris sebelum ctx dibatalkan tetap ditulis ke report
+	expected := "row,column,tag,message\n" +
+		"2,country,required,country is a required field\n" +
+		"3,city,required,city is a required field\n"
+	if report.String() != expected {
+		t.Errorf("expected report\n%s\ngot\n%s", expected, report.String())
+	}
+}
+
+func TestBulkEmptyNestedPointer(t *testing.T) {
+	type row struct {
+		Name    string         `json:"name" validate:"required"`
+		Address *model.Address `json:"address" validate:"omitempty"`
+	}
+
+	input := "name,address.city,address.country\n" +
+		"Budi,,\n" +
+		"Ani,Malang,\n"
+
+	var report bytes.Buffer
+	summary, err := Default().Bulk(context.Background(), strings.NewReader(input), &report, row{}, BulkOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// address kosong tetap nil sehingga omitempty berlaku, address yang terisi sebagian tetap divalidasi
+	if summary != (BulkSummary{Rows: 2, Invalid: 1, Errors: 1}) || !strings.Contains(report.String(), "3,address.country,required,") {
+		t.Errorf("unexpected summary %+v\n%s", summary, report.String())
+	}
+}
@@ -0,0 +1,233 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// ConfigIssue adalah satu tag yang salah konfigurasi, contoh pin=abc
+// Struct dan Field kosong jika tag berasal dari Var
+type ConfigIssue struct {
+	Struct  string
+	Field   string
+	Tag     string
+	Message string
+}
+
+// ConfigError dikembalikan jika ada tag yang parameternya tidak valid, berisi seluruh tag yang salah
+// error ini menandakan kesalahan programmer (bukan kesalahan input user), sebaiknya dicek saat aplikasi start
+type ConfigError struct {
+	Issues []ConfigIssue
+}
+
+func (e *ConfigError) Error() string {
+	lines := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		location := "var"
+		if issue.Struct != "" {
+			location = issue.Struct + "." + issue.Field
+		}
+		lines = append(lines, fmt.Sprintf("%s: tag %q: %s", location, issue.Tag, issue.Message))
+	}
+
+	return "validation: invalid tag configuration\n" + strings.Join(lines, "\n")
+}
+
+// paramChecker mengecek parameter tag custom, contoh parameter pin harus angka
+type paramChecker func(param string) error
+
+// WithStructs mengecek konfigurasi tag struct saat New dipanggil, sehingga kesalahan tag ketahuan saat aplikasi start
+func WithStructs(types ...any) Option {
+	return func(o *options) {
+		o.structs = append(o.structs, types...)
+	}
+}
+
+// paramCheckers membuat pengecekan parameter untuk setiap tag custom project
+func paramCheckers(o *options) map[string]paramChecker {
+	return map[string]paramChecker{
+		TagPin: func(param string) error {
+			length, err := strconv.Atoi(param)
+			if err != nil || length <= 0 {
+				return errors.New("parameter must be a positive integer, e.g. pin=6")
+			}
+			return nil
+		},
+		TagBankAccount: func(param string) error {
+			if _, ok := LookupBank(param); !ok {
+				return fmt.Errorf("unknown bank code %q", param)
+			}
+			return nil
+		},
+		TagPassword: func(param string) error {
+			if _, ok := o.passwordPolicies[param]; !ok {
+				return fmt.Errorf("unknown password policy %q", param)
+			}
+			return nil
+		},
+		TagAvailable: func(param string) error {
+			if param == "" {
+				return errors.New("parameter must name the kind of value, e.g. available=email")
+			}
+			return nil
+		},
+		TagUsername: func(param string) error {
+			if _, ok := usernameProfile(o.usernameProfiles, param); !ok {
+				return fmt.Errorf("unknown username profile %q", param)
+			}
+			return nil
+		},
+	}
+}
+
+// hasFieldPath mengecek field ada di struct, path boleh bertingkat menggunakan titik, contoh Address.City
+func hasFieldPath(typ reflect.Type, path string) bool {
+	for _, name := range strings.Split(path, ".") {
+		for typ.Kind() == reflect.Pointer {
+			typ = typ.Elem()
+		}
+		if typ.Kind() != reflect.Struct {
+			return false
+		}
+
+		field, ok := typ.FieldByName(name)
+		if !ok {
+			return false
+		}
+		typ = field.Type
+	}
+
+	return true
+}
+
+// CheckStructs mengecek konfigurasi tag dari struct yang diberikan beserta struct di dalamnya
+// hasil pengecekan di cache per tipe, jika ada tag yang salah akan mengembalikan *ConfigError
+func (v *Validator) CheckStructs(types ...any) error {
+	var issues []ConfigIssue
+	for _, value := range types {
+		if err := v.checkType(reflect.TypeOf(value)); err != nil {
+			var configError *ConfigError
+			if errors.As(err, &configError) {
+				issues = append(issues, configError.Issues...)
+			}
+		}
+	}
+
+	if len(issues) > 0 {
+		return &ConfigError{Issues: issues}
+	}
+
+	return nil
+}
+
+// checkType mengecek satu tipe dan menyimpan hasilnya di cache, sehingga Struct tidak mengecek ulang tipe yang sama
+func (v *Validator) checkType(typ reflect.Type) error {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil
+	}
+
+	if cached, ok := v.checked.Load(typ); ok {
+		return cached.(checkResult).err
+	}
+
+	var issues []ConfigIssue
+	v.collectIssues(typ, map[reflect.Type]bool{}, &issues)
+
+	var err error
+	if len(issues) > 0 {
+		err = &ConfigError{Issues: issues}
+	}
+	v.checked.Store(typ, checkResult{err: err})
+
+	return err
+}
+
+// checkTag mengecek satu tag untuk Var dan menyimpan hasilnya di cache per tag, sama dengan checkType
+func (v *Validator) checkTag(tag string) error {
+	if cached, ok := v.checkedTags.Load(tag); ok {
+		return cached.(checkResult).err
+	}
+
+	var err error
+	if issues := v.LintTag(tag, nil); len(issues) > 0 {
+		err = &ConfigError{Issues: issues}
+	}
+	v.checkedTags.Store(tag, checkResult{err: err})
+
+	return err
+}
+
+// checkResult disimpan di cache, agar hasil tanpa error (nil) tetap bisa diambil tanpa type assertion yang panic
+type checkResult struct {
+	err error
+}
+
+// collectIssues menelusuri field struct termasuk struct di dalam pointer, slice, array dan map
+func (v *Validator) collectIssues(typ reflect.Type, visited map[reflect.Type]bool, issues *[]ConfigIssue) {
+	typ = elementType(typ)
+	if typ.Kind() != reflect.Struct || visited[typ] {
+		return
+	}
+	visited[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+
+		tag := field.Tag.Get("validate")
+		if tag == "-" {
+			continue
+		}
+
+		for _, issue := range CheckTagKeys(string(field.Tag)) {
+			issue.Struct, issue.Field = typ.String(), field.Name
+			*issues = append(*issues, issue)
+		}
+
+		hasField := func(path string) bool {
+			return hasFieldPath(typ, path)
+		}
+		for _, issue := range v.LintTag(tag, hasField) {
+			issue.Struct, issue.Field = typ.String(), field.Name
+			*issues = append(*issues, issue)
+		}
+
+		for _, issue := range v.lintMods(field) {
+			issue.Struct, issue.Field = typ.String(), field.Name
+			*issues = append(*issues, issue)
+		}
+
+		v.collectIssues(field.Type, visited, issues)
+	}
+}
+
+// splitTag memecah tag validate menjadi satu tag per item, termasuk tag yang dipisah dengan OR '|'
+func splitTag(tag string) []string {
+	var tokens []string
+	for _, part := range strings.Split(tag, ",") {
+		for _, token := range strings.Split(part, "|") {
+			if token = strings.TrimSpace(token); token != "" {
+				tokens = append(tokens, token)
+			}
+		}
+	}
+
+	return tokens
+}
+
+// elementType mengambil tipe element dari pointer, slice, array dan map, contoh []*Address menjadi Address
+func elementType(typ reflect.Type) reflect.Type {
+	for {
+		switch typ.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+			typ = typ.Elem()
+		default:
+			return typ
+		}
+	}
+}
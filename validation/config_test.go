@@ -0,0 +1,72 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type misconfigured struct {
+	Pin      string `validate:"required,pin=enam"`
+	Username string `validate:"field_equals_ignore_case=Email|field_equals_ignore_case=Phone"`
+	Email    string `validate:"required,email"`
+	Accounts []struct {
+		Number string `validate:"bank_account=XYZ"`
+	} `validate:"dive"`
+}
+
+func TestConfigErrorOnStruct(t *testing.T) {
+	err := Default().Struct(misconfigured{Pin: "123456"})
+
+	var configError *ConfigError
+	if !errors.As(err, &configError) {
+		t.Fatalf("expected config error, got %v", err)
+	}
+	if len(configError.Issues) != 3 {
+		t.Fatalf("expected 3 issues, got %v", configError)
+	}
+
+	issue := configError.Issues[1]
+	if issue.Struct != "validation.misconfigured" || issue.Field != "Username" || issue.Tag != "field_equals_ignore_case=Phone" {
+		t.Errorf("unexpected issue %+v", issue)
+	}
+	if !strings.Contains(err.Error(), `validation.misconfigured.Pin: tag "pin=enam"`) {
+		t.Errorf("unexpected message %s", err)
+	}
+
+	// hasil pengecekan di cache, pemanggilan kedua tetap mengembalikan error yang sama tanpa panic
+	if err2 := Default().Struct(&misconfigured{}); err2 != err {
+		t.Errorf("expected cached error, got %v", err2)
+	}
+}
+
+func TestConfigErrorOnStartup(t *testing.T) {
+	if _, err := New(WithStructs(misconfigured{})); err == nil {
+		t.Fatal("expected New to fail on misconfigured struct")
+	}
+
+	type Seller struct {
+		Pin string `validate:"kode"`
+	}
+
+	if _, err := New(WithAlias("kode", "required,pin=x"), WithStructs(Seller{})); err == nil {
+		t.Error("expected alias to be checked")
+	}
+	if _, err := New(WithAlias("kode", "required,len=3"), WithStructs(Seller{})); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestCustomTagsDoNotPanic(t *testing.T) {
+	validate := Default().Validate
+
+	type Login struct {
+		Pin      string `validate:"pin=enam"`
+		Username string `validate:"field_equals_ignore_case=Missing"`
+	}
+
+	// menggunakan validator.Validate langsung (tanpa pengecekan konfigurasi), rule custom cukup mengembalikan false
+	if err := validate.Struct(Login{Pin: "123456", Username: "taufik"}); err == nil {
+		t.Error("expected validation error")
+	}
+}
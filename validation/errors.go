@@ -0,0 +1,379 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// FieldError adalah bentuk JSON dari satu validator.FieldError
+// bentuk ini dibuat tetap (stable) agar frontend bisa langsung mengikat error ke input
+type FieldError struct {
+	// Path adalah lokasi field sesuai nama di request tanpa nama struct root, contoh address[0].city atau schools["SMP"].name
+	Path string `json:"path"`
+	// Pointer adalah lokasi field dalam bentuk JSON pointer (RFC 6901), contoh /address/0/city atau /schools/SMP/name
+	Pointer string `json:"pointer"`
+	// Field adalah nama field sesuai tag json, form atau query, contoh city
+	Field   string `json:"field"`
+	Tag     string `json:"tag"`
+	Param   string `json:"param"`
+	Value   any    `json:"value"`
+	Message string `json:"message"`
+}
+
+// ErrorResponse adalah dokumen JSON yang dikirim ke client saat validasi gagal
+// Truncated bernilai true jika sebagian error dibuang karena ErrorLimit
+type ErrorResponse struct {
+	Errors    []FieldError `json:"errors"`
+	Truncated bool         `json:"truncated,omitempty"`
+}
+
+// FieldErrors mengubah validator.ValidationErrors (termasuk hasil ReportError dari struct level validation)
+// menjadi slice FieldError dengan pesan sesuai locale, error selain ValidationErrors akan mengembalikan nil
+func (v *Validator) FieldErrors(err error, locales ...string) []FieldError {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	trans := v.Translator(locales...)
+
+	fieldErrors := make([]FieldError, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		fieldErrors = append(fieldErrors, FieldError{
+			Path:    errorPath(fieldError),
+			Pointer: fieldPointer(fieldError),
+			Field:   fieldError.Field(),
+			Tag:     fieldError.Tag(),
+			Param:   fieldError.Param(),
+			Value:   jsonValue(fieldError.Value()),
+			Message: fieldError.Translate(trans),
+		})
+	}
+
+	return fieldErrors
+}
+
+// MarshalErrors mengubah error hasil validasi menjadi dokumen JSON ErrorResponse
+func (v *Validator) MarshalErrors(err error, locales ...string) ([]byte, error) {
+	fieldErrors := v.FieldErrors(err, locales...)
+	if fieldErrors == nil {
+		return nil, fmt.Errorf("validation: %w is not validator.ValidationErrors", err)
+	}
+
+	return json.Marshal(ErrorResponse{Errors: fieldErrors, Truncated: Truncated(err)})
+}
+
+// tag yang dibaca untuk menentukan nama field, sesuai urutan prioritas
+var nameTags = []string{"json", "form", "query"}
+
+// fieldName digunakan sebagai TagNameFunc, nama field diambil dari tag json lalu form lalu query
+// sehingga namespace error sama dengan nama field yang dikirim client
+// jika tidak ada tag (atau tag bernilai "-") akan menggunakan nama field struct
+func fieldName(field reflect.StructField) string {
+	for _, tag := range nameTags {
+		name := strings.SplitN(field.Tag.Get(tag), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		if name != "" {
+			return name
+		}
+	}
+
+	return ""
+}
+
+// FieldName mengembalikan nama field yang dipakai di namespace error dan schema, contoh confirm_password
+// jika tidak ada tag json, form atau query akan menggunakan nama field struct
+func FieldName(field reflect.StructField) string {
+	if name := fieldName(field); name != "" {
+		return name
+	}
+
+	return field.Name
+}
+
+// regexMapKey mencari key di dalam namespace, contoh [0] atau [SMP]
+// hanya dipakai untuk error yang lokasinya tidak bisa ditelusuri dari value, key yang berisi ] tidak dikenali
+var regexMapKey = regexp.MustCompile(`\[([^\]]*)\]`)
+
+// wirePath mengubah namespace menjadi path sesuai format request tanpa nama struct root, dipakai jika error tidak
+// memiliki path hasil locate (contoh error dari Var atau Map). key map yang bukan angka akan diberi tanda kutip, contoh User.schools[SMP].name menjadi schools["SMP"].name
+func wirePath(namespace string) string {
+	return regexMapKey.ReplaceAllStringFunc(trimRoot(namespace), func(match string) string {
+		key := match[1 : len(match)-1]
+		if _, err := strconv.Atoi(key); err == nil {
+			return match
+		}
+
+		return "[" + strconv.Quote(key) + "]"
+	})
+}
+
+// trimRoot menghapus nama struct root dari namespace, contoh User.address[0].city menjadi address[0].city
+func trimRoot(namespace string) string {
+	if i := strings.IndexByte(namespace, '.'); i >= 0 {
+		return namespace[i+1:]
+	}
+
+	return namespace
+}
+
+// fieldPointer mengambil JSON pointer dari error, error hasil Struct dan Map sudah menyimpan pointer yang tepat
+// untuk error lain pointer dibuat dari namespace
+func fieldPointer(fieldError validator.FieldError) string {
+	if pointer, ok := fieldError.(interface{ Pointer() string }); ok {
+		return pointer.Pointer()
+	}
+
+	return namespacePointer(trimRoot(fieldError.Namespace()))
+}
+
+// namespacePointer mengubah namespace tanpa root menjadi JSON pointer, contoh address[0].city menjadi /address/0/city
+// key map yang berisi . atau ] tidak bisa dibedakan dari namespace, sehingga hanya dipakai jika locate gagal
+func namespacePointer(namespace string) string {
+	if namespace == "" {
+		return ""
+	}
+
+	var pointer strings.Builder
+	for _, segment := range strings.Split(namespace, ".") {
+		name, keys, _ := strings.Cut(segment, "[")
+		pointer.WriteString("/" + escapePointer(name))
+		if keys == "" {
+			continue
+		}
+		for _, key := range strings.Split(strings.TrimSuffix(keys, "]"), "][") {
+			pointer.WriteString("/" + escapePointer(key))
+		}
+	}
+
+	return pointer.String()
+}
+
+// errorPath mengambil path dari error, sama seperti fieldPointer path dibuat dari namespace jika error tidak
+// memiliki path hasil locate
+func errorPath(fieldError validator.FieldError) string {
+	if path, ok := fieldError.(interface{ Path() string }); ok {
+		return path.Path()
+	}
+
+	return wirePath(fieldError.Namespace())
+}
+
+// errorFields mengambil path field tanpa key collection, contoh address[0].city menjadi address.city
+func errorFields(fieldError validator.FieldError) string {
+	if located, ok := fieldError.(*locatedError); ok {
+		return located.location.fields
+	}
+
+	return regexMapKey.ReplaceAllString(trimRoot(fieldError.Namespace()), "")
+}
+
+// pointerEscaper mengganti ~ dan / di dalam nama field atau key sesuai RFC 6901
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func escapePointer(token string) string {
+	return pointerEscaper.Replace(token)
+}
+
+// jsonValue memastikan value bisa di encode ke JSON, jika tidak akan diubah menjadi string
+func jsonValue(value any) any {
+	if _, err := json.Marshal(value); err != nil {
+		return fmt.Sprint(value)
+	}
+
+	return value
+}
+
+// RuleViolation adalah satu sub-rule yang gagal, contoh sub-rule password atau username
+type RuleViolation struct {
+	Tag   string
+	Param string
+}
+
+// ruleError adalah FieldError hasil pemecahan satu error menjadi beberapa sub-rule, contoh sub-rule password
+// namespace, field dan value tetap sama dengan error aslinya, hanya tag dan param yang berbeda
+type ruleError struct {
+	validator.FieldError
+	tag   string
+	param string
+}
+
+func (e *ruleError) Tag() string {
+	return e.tag
+}
+
+func (e *ruleError) ActualTag() string {
+	return e.tag
+}
+
+func (e *ruleError) Param() string {
+	return e.param
+}
+
+func (e *ruleError) Translate(trans ut.Translator) string {
+	if trans == nil {
+		return e.Error()
+	}
+
+	message, err := trans.T(e.tag, e.Field(), e.param)
+	if err != nil {
+		return e.Error()
+	}
+
+	return message
+}
+
+func (e *ruleError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.Namespace(), e.Field(), e.tag)
+}
+
+// indirect mengambil isi pointer dan interface sampai ke value aslinya
+func indirect(value reflect.Value) reflect.Value {
+	for value.IsValid() && (value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface) {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+
+	return value
+}
+
+// fieldLocation adalah lokasi field error di dalam value yang divalidasi, parent adalah struct yang memiliki field
+// tersebut, contoh error User.Credentials[0].Password memiliki parent User.Credentials[0] dan field Password
+type fieldLocation struct {
+	path          string
+	pointer       string
+	fields        string
+	parent        reflect.Value
+	parentPointer string
+	field         reflect.StructField
+}
+
+// locate menelusuri StructNamespace error dari root, key map dicocokkan dengan key yang ada di map sehingga key
+// yang berisi . atau ] (contoh schools[a.b]) tetap menghasilkan path dan pointer yang benar
+// false dikembalikan jika namespace tidak bisa ditelusuri, contoh error dari Var atau ReportError dengan nama bebas
+func locate(root reflect.Value, structNamespace string) (fieldLocation, bool) {
+	value := indirect(root)
+	if value.Kind() != reflect.Struct {
+		return fieldLocation{}, false
+	}
+
+	rest, ok := strings.CutPrefix(structNamespace, value.Type().Name()+".")
+	if !ok {
+		return fieldLocation{}, false
+	}
+
+	return locateField(value, rest, fieldLocation{})
+}
+
+// locateField menelusuri nama field go di awal rest, contoh Schools pada Schools[a.b].Name
+func locateField(value reflect.Value, rest string, location fieldLocation) (fieldLocation, bool) {
+	value = indirect(value)
+	if value.Kind() != reflect.Struct {
+		return fieldLocation{}, false
+	}
+
+	end := strings.IndexAny(rest, ".[")
+	if end < 0 {
+		end = len(rest)
+	}
+	field, ok := value.Type().FieldByName(rest[:end])
+	if !ok {
+		return fieldLocation{}, false
+	}
+	fieldValue, err := value.FieldByIndexErr(field.Index)
+	if err != nil {
+		return fieldLocation{}, false
+	}
+
+	name := FieldName(field)
+	location.parent, location.parentPointer, location.field = value, location.pointer, field
+	location.pointer += "/" + escapePointer(name)
+	if location.path == "" {
+		location.path, location.fields = name, name
+	} else {
+		location.path, location.fields = location.path+"."+name, location.fields+"."+name
+	}
+
+	return locateKeys(fieldValue, rest[end:], location)
+}
+
+// locateKeys menelusuri key collection di awal rest, contoh [0] atau [a.b], lalu field berikutnya
+func locateKeys(value reflect.Value, rest string, location fieldLocation) (fieldLocation, bool) {
+	switch {
+	case rest == "":
+		return location, true
+	case rest[0] == '.':
+		return locateField(value, rest[1:], location)
+	case rest[0] != '[':
+		return fieldLocation{}, false
+	}
+
+	collection := indirect(value)
+	switch collection.Kind() {
+	case reflect.Slice, reflect.Array:
+		end := strings.IndexByte(rest, ']')
+		if end < 0 {
+			return fieldLocation{}, false
+		}
+		index, err := strconv.Atoi(rest[1:end])
+		if err != nil || index < 0 || index >= collection.Len() {
+			return fieldLocation{}, false
+		}
+		return locateKeys(collection.Index(index), rest[end+1:], location.key(rest[1:end]))
+	case reflect.Map:
+		// key diurutkan agar hasil selalu sama, key lain dicoba jika penelusuran berikutnya gagal
+		keys := collection.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keyString(keys[i]) < keyString(keys[j]) })
+		for _, key := range keys {
+			name := keyString(key)
+			next, ok := strings.CutPrefix(rest, "["+name+"]")
+			if !ok {
+				continue
+			}
+			if found, ok := locateKeys(collection.MapIndex(key), next, location.key(name)); ok {
+				return found, true
+			}
+		}
+	}
+
+	return fieldLocation{}, false
+}
+
+// key menambahkan key collection ke path dan pointer, key yang bukan angka diberi tanda kutip seperti wirePath
+func (l fieldLocation) key(key string) fieldLocation {
+	if _, err := strconv.Atoi(key); err == nil {
+		l.path += "[" + key + "]"
+	} else {
+		l.path += "[" + strconv.Quote(key) + "]"
+	}
+	l.pointer += "/" + escapePointer(key)
+
+	return l
+}
+
+// locatedError adalah error Struct dengan path dan pointer hasil locate
+type locatedError struct {
+	validator.FieldError
+	location fieldLocation
+}
+
+func (e *locatedError) Path() string {
+	return e.location.path
+}
+
+func (e *locatedError) Pointer() string {
+	return e.location.pointer
+}
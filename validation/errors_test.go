@@ -0,0 +1,130 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+)
+
+func TestFieldErrors(t *testing.T) {
+	type Address struct {
+		City string `json:"city" validate:"required"`
+	}
+
+	type User struct {
+		Name    string    `json:"name" validate:"required,min=3"`
+		Address []Address `json:"address" validate:"required,dive"`
+	}
+
+	err := Default().Struct(User{Name: "Ab", Address: []Address{{City: ""}}})
+
+	fieldErrors := Default().FieldErrors(err, LocaleEN)
+	if len(fieldErrors) != 2 {
+		t.Fatalf("expected 2 errors, got %+v", fieldErrors)
+	}
+
+	name := fieldErrors[0]
+	if name.Path != "name" || name.Field != "name" || name.Tag != "min" || name.Param != "3" || name.Value != "Ab" {
+		t.Errorf("unexpected field error: %+v", name)
+	}
+	if name.Message != "name must be at least 3 characters in length" {
+		t.Errorf("unexpected message: %q", name.Message)
+	}
+
+	city := fieldErrors[1]
+	if city.Path != "address[0].city" || city.Field != "city" {
+		t.Errorf("unexpected field error: %+v", city)
+	}
+}
+
+func TestMarshalErrorsStructLevel(t *testing.T) {
+	err := Default().Struct(model.RegisterRequest{
+		Username: "akuutauf@email.com",
+		Email:    "taufik@email.com",
+		Phone:    "081234567890",
+		Password: "rahasia",
+	})
+
+	body, err := Default().MarshalErrors(err, LocaleID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var response ErrorResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		t.Fatal(err)
+	}
+	if len(response.Errors) != 1 || response.Errors[0].Field != "username" || response.Errors[0].Path != "username" {
+		t.Errorf("unexpected response: %s", body)
+	}
+
+	if _, err := Default().MarshalErrors(errors.New("boom")); err == nil {
+		t.Error("expected error for non validation error")
+	}
+}
+
+func TestWirePath(t *testing.T) {
+	user := model.User{
+		Id:      "1",
+		Name:    "Taufik",
+		Address: []model.Address{{City: "Banyuwangi", Country: ""}},
+		Hobbies: []string{"Reading"},
+		Schools: map[string]model.School{"SMP": {Name: ""}},
+		Wallets: map[string]int{"BNI": 100000},
+	}
+
+	fieldErrors := Default().FieldErrors(Default().Struct(user))
+
+	paths := map[string]bool{}
+	for _, fieldError := range fieldErrors {
+		paths[fieldError.Path] = true
+	}
+	for _, path := range []string{"address[0].country", `schools["SMP"].name`} {
+		if !paths[path] {
+			t.Errorf("expected path %s in %v", path, paths)
+		}
+	}
+
+	type Search struct {
+		Keyword string `form:"q" validate:"required"`
+		Page    int    `query:"page" validate:"gt=0"`
+		Secret  string `json:"-" validate:"required"`
+	}
+
+	fieldErrors = Default().FieldErrors(Default().Struct(Search{}))
+	for i, path := range []string{"q", "page", "Secret"} {
+		if fieldErrors[i].Path != path {
+			t.Errorf("expected path %s, got %s", path, fieldErrors[i].Path)
+		}
+	}
+}
+
+func TestMapKeyLocation(t *testing.T) {
+	// key yang berisi . atau ] tidak bisa dibaca dari namespace, lokasi ditelusuri dari value yang divalidasi
+	user := model.User{
+		Id:      "1",
+		Name:    "Taufik",
+		Schools: map[string]model.School{"a.b": {}, "x]y": {}, "c/d": {}},
+	}
+
+	found := map[string]string{}
+	for _, fieldError := range Default().FieldErrors(Default().Struct(user)) {
+		found[fieldError.Pointer] = fieldError.Path
+	}
+	for pointer, path := range map[string]string{
+		"/schools/a.b/name":  `schools["a.b"].name`,
+		"/schools/x]y/name":  `schools["x]y"].name`,
+		"/schools/c~1d/name": `schools["c/d"].name`,
+	} {
+		if found[pointer] != path {
+			t.Errorf("expected %s at %s, got %v", path, pointer, found)
+		}
+	}
+
+	fieldErrors := patchErrors(t, `{"schools": {"a.b": {"name": ""}, "x]y": {}}}`, &model.User{})
+	if len(fieldErrors) != 1 || fieldErrors[0].Pointer != "/schools/a.b/name" {
+		t.Errorf("expected only schools[\"a.b\"].name, got %+v", fieldErrors)
+	}
+}
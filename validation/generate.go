@@ -0,0 +1,136 @@
+package validation
+
+import (
+	"reflect"
+	"strings"
+
+	"belajar-go-lang-validation/model"
+	"belajar-go-lang-validation/validation/generated"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// init mendaftarkan custom validation dan struct level validation ke runtime kode hasil validategen
+// rule dijalankan menggunakan konfigurasi Default(), sehingga hasilnya sama dengan Default().Struct
+func init() {
+	for tag := range customValidations(&options{}) {
+		generated.Register(tag, func(field generated.CustomField) bool {
+			return Default().validations[tag](fieldLevel{field: field, tag: tag})
+		})
+	}
+
+	generated.RegisterStructLevel(reflect.TypeOf(model.RegisterRequest{}).String(), generatedStructLevel(MustValidRegisterSuccess))
+}
+
+// generatedStructLevel mengubah validator.StructLevelFunc menjadi generated.StructLevelFunc
+func generatedStructLevel(fn validator.StructLevelFunc) generated.StructLevelFunc {
+	return func(current any) []generated.Report {
+		level := &structLevel{current: reflect.ValueOf(current)}
+		fn(level)
+		return level.reports
+	}
+}
+
+// fieldLevel adalah implementasi validator.FieldLevel untuk field dari kode hasil validategen
+type fieldLevel struct {
+	field generated.CustomField
+	tag   string
+}
+
+func (f fieldLevel) Top() reflect.Value      { return f.Parent() }
+func (f fieldLevel) Parent() reflect.Value   { return reflect.ValueOf(f.field.Parent) }
+func (f fieldLevel) Field() reflect.Value    { return reflect.ValueOf(f.field.Value) }
+func (f fieldLevel) FieldName() string       { return f.field.FieldName }
+func (f fieldLevel) StructFieldName() string { return f.field.StructFieldName }
+func (f fieldLevel) Param() string           { return f.field.Param }
+func (f fieldLevel) GetTag() string          { return f.tag }
+
+func (f fieldLevel) ExtractType(field reflect.Value) (reflect.Value, reflect.Kind, bool) {
+	return extractType(field)
+}
+
+func (f fieldLevel) GetStructFieldOK() (reflect.Value, reflect.Kind, bool) {
+	return f.GetStructFieldOKAdvanced(f.Parent(), f.Param())
+}
+
+func (f fieldLevel) GetStructFieldOKAdvanced(val reflect.Value, namespace string) (reflect.Value, reflect.Kind, bool) {
+	current, kind, _, found := f.GetStructFieldOKAdvanced2(val, namespace)
+	return current, kind, found
+}
+
+func (f fieldLevel) GetStructFieldOK2() (reflect.Value, reflect.Kind, bool, bool) {
+	return f.GetStructFieldOKAdvanced2(f.Parent(), f.Param())
+}
+
+// GetStructFieldOKAdvanced2 mencari field dengan nama field go, bisa berupa path contoh Address.City
+func (f fieldLevel) GetStructFieldOKAdvanced2(val reflect.Value, namespace string) (reflect.Value, reflect.Kind, bool, bool) {
+	current, kind, nullable := extractType(val)
+	for _, name := range strings.Split(namespace, ".") {
+		if kind != reflect.Struct {
+			return current, kind, nullable, false
+		}
+
+		current = current.FieldByName(name)
+		if !current.IsValid() {
+			return current, reflect.Invalid, nullable, false
+		}
+		current, kind, nullable = extractType(current)
+	}
+
+	return current, kind, nullable, true
+}
+
+// extractType mengambil value di balik pointer dan interface, nullable bernilai true jika melewati pointer
+func extractType(current reflect.Value) (reflect.Value, reflect.Kind, bool) {
+	nullable := false
+	for current.Kind() == reflect.Pointer || current.Kind() == reflect.Interface {
+		nullable = nullable || current.Kind() == reflect.Pointer
+		if current.IsNil() {
+			return current, current.Kind(), nullable
+		}
+		current = current.Elem()
+	}
+
+	return current, current.Kind(), nullable
+}
+
+// structLevel adalah implementasi validator.StructLevel untuk struct dari kode hasil validategen
+type structLevel struct {
+	current reflect.Value
+	reports []generated.Report
+}
+
+func (s *structLevel) Validator() *validator.Validate { return Default().Validate }
+func (s *structLevel) Top() reflect.Value             { return s.current }
+func (s *structLevel) Parent() reflect.Value          { return s.current }
+func (s *structLevel) Current() reflect.Value         { return s.current }
+
+func (s *structLevel) ExtractType(field reflect.Value) (reflect.Value, reflect.Kind, bool) {
+	return extractType(field)
+}
+
+func (s *structLevel) ReportError(field any, fieldName, structFieldName, tag, param string) {
+	if value, ok := field.(reflect.Value); ok {
+		field = value.Interface()
+	}
+
+	s.reports = append(s.reports, generated.Report{
+		Value:       field,
+		Field:       fieldName,
+		StructField: structFieldName,
+		Tag:         tag,
+		Param:       param,
+	})
+}
+
+func (s *structLevel) ReportValidationErrors(relativeNamespace, relativeActualNamespace string, errs validator.ValidationErrors) {
+	for _, fieldError := range errs {
+		s.reports = append(s.reports, generated.Report{
+			Value:       fieldError.Value(),
+			Field:       relativeNamespace + fieldError.Namespace(),
+			StructField: relativeActualNamespace + fieldError.StructNamespace(),
+			Tag:         fieldError.Tag(),
+			Param:       fieldError.Param(),
+		})
+	}
+}
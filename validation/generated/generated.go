@@ -0,0 +1,345 @@
+// package generated adalah runtime untuk kode hasil validategen, berisi tipe error dan registry rule custom
+//
+// package ini sengaja tidak import package validation, agar kode hasil generate di package model
+// tidak membuat import cycle. rule custom (username, nik, dll) di register oleh package validation saat init
+package generated
+
+import (
+	"fmt"
+	"net/mail"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode/utf8"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// regex yang sama dengan validator package, agar hasil validasi kode generate identik
+var (
+	regexNumber       = regexp.MustCompile("^[0-9]+$")
+	regexNumeric      = regexp.MustCompile("^[-+]?[0-9]+(?:\\.[0-9]+)?$")
+	regexAlpha        = regexp.MustCompile("^[a-zA-Z]+$")
+	regexAlphaNumeric = regexp.MustCompile("^[a-zA-Z0-9]+$")
+	regexEmail        = regexp.MustCompile("^(?:(?:(?:(?:[a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+(?:\\.([a-zA-Z]|\\d|[!#\\$%&'\\*\\+\\-\\/=\\?\\^_`{\\|}~]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])+)*)|(?:(?:\\x22)(?:(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(?:\\x20|\\x09)+)?(?:(?:[\\x01-\\x08\\x0b\\x0c\\x0e-\\x1f\\x7f]|\\x21|[\\x23-\\x5b]|[\\x5d-\\x7e]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[\\x01-\\x09\\x0b\\x0c\\x0d-\\x7f]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}]))))*(?:(?:(?:\\x20|\\x09)*(?:\\x0d\\x0a))?(\\x20|\\x09)+)?(?:\\x22))))@(?:(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|\\d|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.)+(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])|(?:(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])(?:[a-zA-Z]|\\d|-|\\.|~|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])*(?:[a-zA-Z]|[\\x{00A0}-\\x{D7FF}\\x{F900}-\\x{FDCF}\\x{FDF0}-\\x{FFEF}])))\\.?$")
+)
+
+// IsEmail sama dengan tag email
+func IsEmail(value string) bool {
+	if _, err := mail.ParseAddress(value); err != nil {
+		return false
+	}
+
+	return regexEmail.MatchString(value)
+}
+
+// IsNumber sama dengan tag number untuk string
+func IsNumber(value string) bool {
+	return regexNumber.MatchString(value)
+}
+
+// IsNumeric sama dengan tag numeric untuk string
+func IsNumeric(value string) bool {
+	return regexNumeric.MatchString(value)
+}
+
+// IsAlpha sama dengan tag alpha
+func IsAlpha(value string) bool {
+	return regexAlpha.MatchString(value)
+}
+
+// IsAlphanum sama dengan tag alphanum
+func IsAlphanum(value string) bool {
+	return regexAlphaNumeric.MatchString(value)
+}
+
+// Length menghitung panjang string dalam jumlah karakter (rune), sama dengan tag min, max dan len
+func Length(value string) int {
+	return utf8.RuneCountInString(value)
+}
+
+// FieldError adalah implementasi validator.FieldError untuk kode hasil generate
+type FieldError struct {
+	tag             string
+	actualTag       string
+	namespace       string
+	structNamespace string
+	field           string
+	structField     string
+	value           any
+	param           string
+}
+
+func (e *FieldError) Tag() string             { return e.tag }
+func (e *FieldError) ActualTag() string       { return e.actualTag }
+func (e *FieldError) Namespace() string       { return e.namespace }
+func (e *FieldError) StructNamespace() string { return e.structNamespace }
+func (e *FieldError) Field() string           { return e.field }
+func (e *FieldError) StructField() string     { return e.structField }
+func (e *FieldError) Value() any              { return e.value }
+func (e *FieldError) Param() string           { return e.param }
+
+func (e *FieldError) Kind() reflect.Kind {
+	return reflect.ValueOf(e.value).Kind()
+}
+
+func (e *FieldError) Type() reflect.Type {
+	return reflect.TypeOf(e.value)
+}
+
+// Translate menerjemahkan pesan error dengan translation yang sudah di register ke translator
+// tag min, max, len, gt, gte, lt dan lte memiliki pesan berbeda untuk string, angka dan collection,
+// sama seperti translation bawaan validator package
+func (e *FieldError) Translate(trans ut.Translator) string {
+	if trans == nil {
+		return e.Error()
+	}
+
+	if message, ok := e.translateSize(trans); ok {
+		return message
+	}
+
+	message, err := trans.T(e.tag, e.field, e.param)
+	if err != nil {
+		return e.Error()
+	}
+
+	return message
+}
+
+// translateSize menerjemahkan tag ukuran dengan key <tag>-string, <tag>-number dan <tag>-items
+func (e *FieldError) translateSize(trans ut.Translator) (string, bool) {
+	switch e.tag {
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+	default:
+		return "", false
+	}
+
+	number, err := strconv.ParseFloat(e.param, 64)
+	if err != nil {
+		return "", false
+	}
+
+	var digits uint64
+	if index := strings.Index(e.param, "."); index != -1 {
+		digits = uint64(len(e.param[index+1:]))
+	}
+
+	var message string
+	switch e.Kind() {
+	case reflect.String:
+		count, err := trans.C(e.tag+"-string-character", number, digits, trans.FmtNumber(number, digits))
+		if err != nil {
+			return "", false
+		}
+		message, err = trans.T(e.tag+"-string", e.field, count)
+	case reflect.Slice, reflect.Map, reflect.Array:
+		count, err := trans.C(e.tag+"-items-item", number, digits, trans.FmtNumber(number, digits))
+		if err != nil {
+			return "", false
+		}
+		message, err = trans.T(e.tag+"-items", e.field, count)
+	default:
+		message, err = trans.T(e.tag+"-number", e.field, trans.FmtNumber(number, digits))
+	}
+	if err != nil {
+		return "", false
+	}
+
+	return message, true
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.namespace, e.field, e.tag)
+}
+
+// Path adalah lokasi field yang sedang divalidasi
+// string namespace baru dibuat saat ada error, sehingga validasi yang sukses tidak melakukan alokasi
+type Path struct {
+	parent     *Path
+	name       string
+	structName string
+	key        string
+	index      int
+	kind       uint8
+}
+
+const (
+	pathField uint8 = iota
+	pathIndex
+	pathKey
+)
+
+// Root membuat path untuk struct paling luar, contoh Root("User")
+func Root(name string) Path {
+	return Path{name: name, structName: name}
+}
+
+// Field membuat path untuk field di dalam struct, name adalah nama di json dan structName nama field go
+func (p *Path) Field(name, structName string) Path {
+	return Path{parent: p, name: name, structName: structName}
+}
+
+// Index membuat path untuk element slice, contoh address[0]
+func (p *Path) Index(index int) Path {
+	return Path{parent: p, index: index, kind: pathIndex}
+}
+
+// Key membuat path untuk element map, contoh schools[SMP]
+func (p *Path) Key(key string) Path {
+	return Path{parent: p, key: key, kind: pathKey}
+}
+
+// Namespace mengembalikan namespace dengan nama json dan nama field go, contoh User.address[0].city dan User.Address[0].City
+// field dan structField adalah bagian terakhir namespace, contoh city atau hobbies[1]
+func (p *Path) Namespace() (namespace, structNamespace, field, structField string) {
+	var names, structNames strings.Builder
+	fieldStart, structFieldStart := p.write(&names, &structNames)
+
+	namespace, structNamespace = names.String(), structNames.String()
+	return namespace, structNamespace, namespace[fieldStart:], structNamespace[structFieldStart:]
+}
+
+// write menulis namespace dari root sampai path ini, mengembalikan posisi awal nama field terakhir
+func (p *Path) write(names, structNames *strings.Builder) (fieldStart, structFieldStart int) {
+	if p.parent != nil {
+		fieldStart, structFieldStart = p.parent.write(names, structNames)
+	}
+
+	switch p.kind {
+	case pathIndex:
+		suffix := "[" + strconv.Itoa(p.index) + "]"
+		names.WriteString(suffix)
+		structNames.WriteString(suffix)
+	case pathKey:
+		suffix := "[" + p.key + "]"
+		names.WriteString(suffix)
+		structNames.WriteString(suffix)
+	default:
+		if p.parent != nil {
+			names.WriteByte('.')
+			structNames.WriteByte('.')
+		}
+		fieldStart, structFieldStart = names.Len(), structNames.Len()
+		names.WriteString(p.name)
+		structNames.WriteString(p.structName)
+	}
+
+	return fieldStart, structFieldStart
+}
+
+// Errors mengumpulkan error dari kode hasil generate
+type Errors struct {
+	errors validator.ValidationErrors
+}
+
+// Add menambahkan error untuk field di path, tag adalah nama tag di struct (bisa alias) dan actualTag tag yang gagal
+func (e *Errors) Add(path *Path, tag, actualTag, param string, value any) {
+	namespace, structNamespace, field, structField := path.Namespace()
+	e.errors = append(e.errors, &FieldError{
+		tag:             tag,
+		actualTag:       actualTag,
+		namespace:       namespace,
+		structNamespace: structNamespace,
+		field:           field,
+		structField:     structField,
+		value:           value,
+		param:           param,
+	})
+}
+
+// Report menambahkan error hasil struct level validation, path adalah lokasi struct
+func (e *Errors) Report(path *Path, reports []Report) {
+	for _, report := range reports {
+		field := path.Field(report.Field, report.StructField)
+		e.Add(&field, report.Tag, report.Tag, report.Param, report.Value)
+	}
+}
+
+// Err mengembalikan validator.ValidationErrors, atau nil jika tidak ada error
+func (e *Errors) Err() error {
+	if len(e.errors) == 0 {
+		return nil
+	}
+
+	return e.errors
+}
+
+// CustomField adalah informasi field yang dikirim ke rule custom
+type CustomField struct {
+	Value           any
+	Param           string
+	Parent          any
+	FieldName       string
+	StructFieldName string
+}
+
+// CustomFunc adalah rule custom, mengembalikan true jika valid
+type CustomFunc func(field CustomField) bool
+
+// Report adalah error yang dilaporkan oleh struct level validation, sama dengan parameter StructLevel.ReportError
+type Report struct {
+	Value       any
+	Field       string
+	StructField string
+	Tag         string
+	Param       string
+}
+
+// StructLevelFunc adalah struct level validation, current adalah struct yang sedang divalidasi
+type StructLevelFunc func(current any) []Report
+
+var (
+	mutex       sync.RWMutex
+	customs     = map[string]CustomFunc{}
+	structLevel = map[string]StructLevelFunc{}
+)
+
+// Register mendaftarkan rule custom untuk tag tertentu
+func Register(tag string, fn CustomFunc) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	customs[tag] = fn
+}
+
+// RegisterStructLevel mendaftarkan struct level validation, typeName sama dengan reflect.Type.String(), contoh model.RegisterRequest
+func RegisterStructLevel(typeName string, fn StructLevelFunc) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	structLevel[typeName] = fn
+}
+
+// Custom menjalankan rule custom, panic jika tag belum di register, sama seperti validator package untuk tag yang tidak dikenal
+func Custom(tag string, field CustomField) bool {
+	mutex.RLock()
+	fn, ok := customs[tag]
+	mutex.RUnlock()
+
+	if !ok {
+		panic("generated: undefined validation function '" + tag + "', import belajar-go-lang-validation/validation to register it")
+	}
+
+	return fn(field)
+}
+
+// Registered mengecek tag custom sudah di register
+func Registered(tag string) bool {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	_, ok := customs[tag]
+	return ok
+}
+
+// LookupStructLevel mengembalikan struct level validation untuk tipe tersebut, nil jika tidak ada
+func LookupStructLevel(typeName string) StructLevelFunc {
+	mutex.RLock()
+	defer mutex.RUnlock()
+
+	return structLevel[typeName]
+}
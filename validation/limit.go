@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// ErrorLimit membatasi jumlah error yang dikembalikan oleh satu kali validasi, nilai 0 berarti tidak dibatasi
+// contoh User dengan ribuan Hobbies yang tidak valid cukup mengembalikan beberapa error pertama
+type ErrorLimit struct {
+	// MaxErrors adalah jumlah error maksimal untuk struct (atau value) yang divalidasi
+	MaxErrors int
+	// MaxCollectionErrors adalah jumlah error maksimal untuk setiap slice, array dan map, contoh hobbies
+	// collection di dalam element collection lain (contoh address[0].phones) juga dihitung sendiri
+	MaxCollectionErrors int
+}
+
+// FailFast hanya mengembalikan error pertama
+var FailFast = ErrorLimit{MaxErrors: 1}
+
+type errorLimitKey struct{}
+
+// WithErrorLimit memilih batas jumlah error untuk validasi yang menggunakan ctx, contoh StructCtx, StructParallel,
+// PatchCtx, MapCtx dan VarCtx. Jika ada error yang dibuang, error yang dikembalikan adalah *TruncatedErrors
+//
+// StructCtx dan StructParallel berhenti memvalidasi element slice, array dan map di field struct paling luar setelah
+// error collection tersebut melebihi batas, sehingga ribuan Hobbies yang tidak valid tidak divalidasi seluruhnya.
+// MapCtx berhenti setelah MaxErrors terlampaui. Field lain tetap divalidasi lalu errornya dipotong
+func WithErrorLimit(ctx context.Context, limit ErrorLimit) context.Context {
+	return context.WithValue(ctx, errorLimitKey{}, limit)
+}
+
+// errorLimitFrom mengambil batas dari WithErrorLimit, tanpa batas jika ctx tidak memilikinya
+func errorLimitFrom(ctx context.Context) ErrorLimit {
+	limit, _ := ctx.Value(errorLimitKey{}).(ErrorLimit)
+	return limit
+}
+
+// TruncatedErrors dikembalikan jika sebagian error dibuang karena ErrorLimit, Errors adalah error yang tersisa
+// Unwrap mengembalikan Errors, sehingga errors.As ke validator.ValidationErrors, FieldErrors dan Translate tetap bisa digunakan
+type TruncatedErrors struct {
+	Errors validator.ValidationErrors
+}
+
+func (e *TruncatedErrors) Error() string {
+	return e.Errors.Error() + " (truncated)"
+}
+
+func (e *TruncatedErrors) Unwrap() error {
+	return e.Errors
+}
+
+// Truncated mengecek error hasil validasi tidak lengkap karena ErrorLimit
+func Truncated(err error) bool {
+	var truncatedErrors *TruncatedErrors
+	return errors.As(err, &truncatedErrors)
+}
+
+// enabled mengecek ada batas yang dipilih
+func (l ErrorLimit) enabled() bool {
+	return l.MaxErrors > 0 || l.MaxCollectionErrors > 0
+}
+
+// collectionBound adalah jumlah error element satu collection di field struct paling luar yang masih bisa dikembalikan
+// setelah kept error sebelumnya, -1 berarti tidak dibatasi. Jika error collection melebihi bound, apply pasti membuang
+// error, sehingga element berikutnya tidak perlu divalidasi
+func (l ErrorLimit) collectionBound(kept int) int {
+	bound := -1
+	if l.MaxCollectionErrors > 0 {
+		bound = l.MaxCollectionErrors
+	}
+	if l.MaxErrors > 0 {
+		if remaining := max(l.MaxErrors-kept, 0); bound < 0 || remaining < bound {
+			bound = remaining
+		}
+	}
+
+	return bound
+}
+
+// apply membuang error yang melebihi batas sesuai urutan error, error selain validator.ValidationErrors tidak diubah
+func (l ErrorLimit) apply(err error) error {
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok || !l.enabled() {
+		return err
+	}
+
+	kept := l.keep(validationErrors)
+	if len(kept) == len(validationErrors) {
+		return err
+	}
+
+	return &TruncatedErrors{Errors: kept}
+}
+
+// keep mengembalikan error yang tidak melebihi batas
+func (l ErrorLimit) keep(validationErrors validator.ValidationErrors) validator.ValidationErrors {
+	counts := map[string]int{}
+	var kept validator.ValidationErrors
+	for _, fieldError := range validationErrors {
+		if l.MaxErrors > 0 && len(kept) == l.MaxErrors {
+			break
+		}
+
+		collections := collectionNamespaces(fieldError.Namespace())
+		if l.MaxCollectionErrors > 0 && exceeds(counts, collections, l.MaxCollectionErrors) {
+			continue
+		}
+		for _, collection := range collections {
+			counts[collection]++
+		}
+		kept = append(kept, fieldError)
+	}
+
+	return kept
+}
+
+// exceeds mengecek salah satu collection sudah mencapai batas
+func exceeds(counts map[string]int, collections []string, limit int) bool {
+	for _, collection := range collections {
+		if counts[collection] >= limit {
+			return true
+		}
+	}
+
+	return false
+}
+
+// collectionNamespaces mengembalikan namespace seluruh collection yang berisi field error
+// contoh User.address[0].phones[1] menjadi User.address dan User.address[0].phones, dan User.matrix[0][1]
+// menjadi User.matrix dan User.matrix[0]
+func collectionNamespaces(namespace string) []string {
+	var collections []string
+	for i := range len(namespace) {
+		if namespace[i] == '[' {
+			collections = append(collections, namespace[:i])
+		}
+	}
+
+	return collections
+}
@@ -0,0 +1,194 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+
+	"belajar-go-lang-validation/model"
+)
+
+func TestErrorLimit(t *testing.T) {
+	validate := Default()
+	user := model.User{Name: "Taufik", Address: []model.Address{{}, {}}, Hobbies: []string{"Coding"}}
+	for range 1000 {
+		user.Hobbies = append(user.Hobbies, "TV")
+	}
+
+	full := validate.FieldErrors(validate.Struct(user))
+	if len(full) != 1005 {
+		t.Fatalf("expected 1005 errors, got %d", len(full))
+	}
+
+	tests := []struct {
+		limit    ErrorLimit
+		expected []string
+	}{
+		{FailFast, []string{"id"}},
+		{ErrorLimit{MaxErrors: 3}, []string{"id", "address[0].city", "address[0].country"}},
+		{ErrorLimit{MaxCollectionErrors: 2}, []string{"id", "address[0].city", "address[0].country", "hobbies[1]", "hobbies[2]"}},
+		{ErrorLimit{MaxErrors: 4, MaxCollectionErrors: 1}, []string{"id", "address[0].city", "hobbies[1]"}},
+	}
+
+	for _, test := range tests {
+		ctx := WithErrorLimit(context.Background(), test.limit)
+		err := validate.StructCtx(ctx, user)
+		if !Truncated(err) {
+			t.Errorf("%+v: expected truncated error, got %v", test.limit, err)
+		}
+
+		var paths []string
+		for _, fieldError := range validate.FieldErrors(err) {
+			paths = append(paths, fieldError.Path)
+		}
+		if strings.Join(paths, " ") != strings.Join(test.expected, " ") {
+			t.Errorf("%+v: expected %v, got %v", test.limit, test.expected, paths)
+		}
+	}
+
+	// batas yang tidak tercapai mengembalikan validator.ValidationErrors biasa
+	err := validate.StructCtx(WithErrorLimit(context.Background(), ErrorLimit{MaxErrors: 10}), model.LoginRequest{})
+	var validationErrors validator.ValidationErrors
+	if Truncated(err) || !errors.As(err, &validationErrors) || len(validationErrors) != 2 {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	body, err := validate.MarshalErrors(validate.StructCtx(WithErrorLimit(context.Background(), FailFast), user))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var response ErrorResponse
+	if err := json.Unmarshal(body, &response); err != nil || !response.Truncated || len(response.Errors) != 1 {
+		t.Errorf("unexpected response %s", body)
+	}
+}
+
+func TestErrorLimitNotReached(t *testing.T) {
+	validate := Default()
+	user := model.User{
+		Id:      "1",
+		Name:    "Taufik",
+		Address: []model.Address{{City: "Malang", Country: "Indonesia"}},
+		Hobbies: []string{"Coding"},
+		Schools: map[string]model.School{"SD": {Name: "SD 1"}, "S": {}},
+		Wallets: map[string]int{"BCA": 5000, "XYZ": 10},
+	}
+
+	expected := errorPaths(validate.FieldErrors(validate.Struct(user)))
+	sort.Strings(expected)
+
+	// batas yang tidak tercapai tidak mengubah hasil validasi
+	err := validate.StructCtx(WithErrorLimit(context.Background(), ErrorLimit{MaxErrors: 100}), user)
+	paths := errorPaths(validate.FieldErrors(err))
+	sort.Strings(paths)
+	if Truncated(err) || !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected same errors as Struct without truncation\n got: %v\nwant: %v", paths, expected)
+	}
+}
+
+func TestErrorLimitPatch(t *testing.T) {
+	var user model.User
+	presence, err := DecodePartial([]byte(`{"name": "", "hobbies": ["TV", "TV", "TV"]}`), &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// id tidak dikirim, sehingga error pertama adalah name
+	validate := Default()
+	err = validate.PatchCtx(WithErrorLimit(context.Background(), ErrorLimit{MaxErrors: 2}), user, presence)
+	fieldErrors := validate.FieldErrors(err)
+	if !Truncated(err) || len(fieldErrors) != 2 || fieldErrors[0].Path != "name" || fieldErrors[1].Path != "hobbies[0]" {
+		t.Errorf("unexpected errors %+v", fieldErrors)
+	}
+}
+
+func TestErrorLimitParallel(t *testing.T) {
+	type batch struct {
+		Items []string `json:"items" validate:"dive,required"`
+	}
+
+	items := make([]string, 10000)
+	for i := range items {
+		if i%2 == 1 {
+			items[i] = fmt.Sprint(i)
+		}
+	}
+
+	validate := Default()
+	ctx := WithErrorLimit(context.Background(), ErrorLimit{MaxCollectionErrors: 3})
+	for range 5 {
+		err := validate.StructParallel(ctx, batch{Items: items}, ParallelOptions{Workers: 8, MinItems: 1})
+		fieldErrors := validate.FieldErrors(err)
+		if !Truncated(err) || len(fieldErrors) != 3 || fieldErrors[0].Path != "items[0]" || fieldErrors[2].Path != "items[4]" {
+			t.Fatalf("unexpected errors %+v", fieldErrors)
+		}
+	}
+
+	// tepat sebanyak batas tidak membuang error, sama dengan StructCtx
+	exact := make([]string, 10000)
+	for i := range exact {
+		exact[i] = "ada"
+	}
+	exact[10], exact[500], exact[9000] = "", "", ""
+	for _, validateFn := range []func(context.Context, any) error{
+		validate.StructCtx,
+		func(ctx context.Context, s any) error {
+			return validate.StructParallel(ctx, s, ParallelOptions{Workers: 8, MinItems: 1})
+		},
+	} {
+		err := validateFn(WithErrorLimit(context.Background(), ErrorLimit{MaxErrors: 3}), batch{Items: exact})
+		if Truncated(err) || len(validate.FieldErrors(err)) != 3 {
+			t.Errorf("expected 3 errors without truncation, got %v", err)
+		}
+	}
+}
+
+func TestErrorLimitStopsEarly(t *testing.T) {
+	type batch struct {
+		Name  string   `json:"name" validate:"required"`
+		Items []string `json:"items" validate:"dive,counted"`
+	}
+
+	validate, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var calls atomic.Int64
+	if err := validate.RegisterValidation("counted", func(validator.FieldLevel) bool {
+		calls.Add(1)
+		return false
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := WithErrorLimit(context.Background(), FailFast)
+	err = validate.StructCtx(ctx, batch{Items: make([]string, 10000)})
+	if fieldErrors := validate.FieldErrors(err); !Truncated(err) || len(fieldErrors) != 1 || fieldErrors[0].Path != "name" {
+		t.Errorf("unexpected errors %+v", fieldErrors)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("expected validation to stop after the first item, got %d calls", calls.Load())
+	}
+
+	calls.Store(0)
+	objects := make([]any, 1000)
+	for i := range objects {
+		objects[i] = map[string]any{"name": "x"}
+	}
+	rules := map[string]any{"items": MapRule{Tag: "dive", Fields: map[string]any{"name": "counted"}}}
+	err = validate.MapRulesCtx(ctx, map[string]any{"items": objects}, rules)
+	if !Truncated(err) || len(validate.FieldErrors(err)) != 1 {
+		t.Errorf("unexpected errors %v", err)
+	}
+	if calls.Load() != 2 {
+		t.Errorf("expected map validation to stop after the limit is exceeded, got %d calls", calls.Load())
+	}
+}
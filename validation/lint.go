@@ -0,0 +1,181 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ValidateTagKey adalah nama key struct tag yang dibaca validator
+const ValidateTagKey = "validate"
+
+// tag yang diproses langsung oleh parser validator, bukan fungsi validasi
+var structureTags = map[string]bool{
+	"-":             true,
+	"dive":          true,
+	"keys":          true,
+	"endkeys":       true,
+	"omitempty":     true,
+	"omitnil":       true,
+	"omitzero":      true,
+	"structonly":    true,
+	"nostructlevel": true,
+}
+
+// tag yang parameternya berisi nama field lain di struct yang sama, nilai map adalah cara mengambil nama field
+var fieldReferenceTags = map[string]func(param string) []string{
+	"eqfield":                singleField,
+	"nefield":                singleField,
+	"gtfield":                singleField,
+	"gtefield":               singleField,
+	"ltfield":                singleField,
+	"ltefield":               singleField,
+	"fieldcontains":          singleField,
+	"fieldexcludes":          singleField,
+	TagFieldEqualsIgnoreCase: singleField,
+	"required_with":          strings.Fields,
+	"required_with_all":      strings.Fields,
+	"required_without":       strings.Fields,
+	"required_without_all":   strings.Fields,
+	"excluded_with":          strings.Fields,
+	"excluded_with_all":      strings.Fields,
+	"excluded_without":       strings.Fields,
+	"excluded_without_all":   strings.Fields,
+	"required_if":            fieldValuePairs,
+	"required_unless":        fieldValuePairs,
+	"excluded_if":            fieldValuePairs,
+	"excluded_unless":        fieldValuePairs,
+}
+
+func singleField(param string) []string {
+	return []string{param}
+}
+
+// fieldValuePairs mengambil nama field dari parameter berpasangan, contoh "Status active Role admin"
+func fieldValuePairs(param string) []string {
+	var fields []string
+	for i, word := range strings.Fields(param) {
+		if i%2 == 0 {
+			fields = append(fields, word)
+		}
+	}
+
+	return fields
+}
+
+// LintTag mengecek satu tag validate: tag yang tidak dikenal, parameter tag custom dan referensi ke field lain
+// hasField digunakan untuk mengecek field yang direferensikan (contoh eqfield=Password), nil berarti tidak dicek
+func (v *Validator) LintTag(tag string, hasField func(path string) bool) []ConfigIssue {
+	var issues []ConfigIssue
+	for _, token := range splitTag(tag) {
+		name, param, _ := strings.Cut(token, "=")
+
+		if expanded, ok := v.aliases[name]; ok {
+			issues = append(issues, v.LintTag(expanded, hasField)...)
+			continue
+		}
+
+		if !v.KnownTag(name) {
+			issues = append(issues, ConfigIssue{Tag: token, Message: fmt.Sprintf("unknown tag %q, it is not a built-in tag, alias or registered custom validation", name)})
+			continue
+		}
+
+		if check, ok := v.paramCheckers[name]; ok {
+			if err := check(param); err != nil {
+				issues = append(issues, ConfigIssue{Tag: token, Message: err.Error()})
+			}
+		}
+
+		if fields, ok := fieldReferenceTags[name]; ok {
+			if err := checkFieldReferences(fields(param), hasField); err != nil {
+				issues = append(issues, ConfigIssue{Tag: token, Message: err.Error()})
+			}
+		}
+	}
+
+	return issues
+}
+
+// checkFieldReferences memastikan parameter berisi nama field dan field tersebut ada
+func checkFieldReferences(fields []string, hasField func(path string) bool) error {
+	if len(fields) == 0 || fields[0] == "" {
+		return errors.New("parameter must name another field")
+	}
+	if hasField == nil {
+		return nil
+	}
+
+	for _, field := range fields {
+		if !hasField(field) {
+			return fmt.Errorf("field %q does not exist", field)
+		}
+	}
+
+	return nil
+}
+
+// KnownTag mengecek nama tag adalah tag bawaan validator, alias atau custom validation yang sudah di register
+func (v *Validator) KnownTag(name string) bool {
+	if _, ok := v.aliases[name]; ok {
+		return true
+	}
+
+	return structureTags[name] || builtinTags[name] || v.customTags[name]
+}
+
+// regexTagKey mencari setiap key di struct tag beserta pemisahnya, contoh validate:" atau validate="
+var regexTagKey = regexp.MustCompile(`([A-Za-z_][A-Za-z0-9_.-]*)(\s*[:=]\s*)"`)
+
+// CheckTagKeys mengecek raw struct tag untuk key validate yang salah tulis, contoh validate="required"
+// (sama dengan, bukan titik dua), valdiate:"required" (salah ketik) atau validate: "required" (ada spasi)
+// tag dengan kesalahan seperti ini diabaikan oleh reflect.StructTag, sehingga tidak ada rule yang dijalankan
+func CheckTagKeys(raw string) []ConfigIssue {
+	var issues []ConfigIssue
+	for _, match := range regexTagKey.FindAllStringSubmatch(raw, -1) {
+		key, separator := match[1], match[2]
+		if !resemblesValidateKey(key) {
+			continue
+		}
+
+		switch {
+		case key == ValidateTagKey && separator == ":":
+			continue
+		case key == ValidateTagKey:
+			issues = append(issues, ConfigIssue{Tag: match[0], Message: `malformed struct tag, use validate:"..." without spaces or '='`})
+		default:
+			issues = append(issues, ConfigIssue{Tag: match[0], Message: fmt.Sprintf("misspelled struct tag key %q, did you mean %q", key, ValidateTagKey)})
+		}
+	}
+
+	return issues
+}
+
+// resemblesValidateKey mengecek key mirip dengan validate (maksimal beda 2 huruf) atau validation
+func resemblesValidateKey(key string) bool {
+	lower := strings.ToLower(key)
+	return lower == "validation" || levenshtein(lower, ValidateTagKey) <= 2
+}
+
+// levenshtein menghitung jumlah minimal perubahan huruf (tambah, hapus, ganti) dari a menjadi b
+func levenshtein(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+
+	return previous[len(b)]
+}
@@ -0,0 +1,144 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// malformedRequest dibuat dengan reflect.StructOf, karena go vet menolak struct tag dengan format yang salah
+func malformedRequest() any {
+	fields := []reflect.StructField{
+		{Name: "Username", Type: reflect.TypeFor[string](), Tag: `validate="required"`},
+		{Name: "Email", Type: reflect.TypeFor[string](), Tag: `json:"email" valdiate:"required,email"`},
+		{Name: "Phone", Type: reflect.TypeFor[string](), Tag: `validate:"required,phone_idd"`},
+		{Name: "Password", Type: reflect.TypeFor[string](), Tag: `validate:"required"`},
+		{Name: "ConfirmPassword", Type: reflect.TypeFor[string](), Tag: `validate:"required,eqfield=Pasword"`},
+		{Name: "Reason", Type: reflect.TypeFor[string](), Tag: `validate:"required_if=Statuss rejected"`},
+	}
+
+	return reflect.New(reflect.StructOf(fields)).Elem().Interface()
+}
+
+func TestLintStruct(t *testing.T) {
+	err := Default().CheckStructs(malformedRequest())
+
+	var configError *ConfigError
+	if !errors.As(err, &configError) {
+		t.Fatalf("expected config error, got %v", err)
+	}
+
+	expected := map[string]string{
+		"Username":        `validate="`,
+		"Email":           `valdiate:"`,
+		"Phone":           "phone_idd",
+		"ConfirmPassword": "eqfield=Pasword",
+		"Reason":          "required_if=Statuss rejected",
+	}
+	if len(configError.Issues) != len(expected) {
+		t.Fatalf("expected %d issues, got %v", len(expected), configError)
+	}
+	for _, issue := range configError.Issues {
+		if expected[issue.Field] != issue.Tag {
+			t.Errorf("unexpected issue %+v", issue)
+		}
+	}
+
+	// validator package akan panic untuk tag yang tidak dikenal, Validator mengembalikan ConfigError
+	if err := Default().Struct(malformedRequest()); !errors.As(err, &configError) {
+		t.Errorf("expected config error instead of panic, got %v", err)
+	}
+}
+
+func TestKnownTag(t *testing.T) {
+	for _, name := range []string{"required", "email", "omitempty", "dive", "varchar", TagNIK, TagPassword} {
+		if !Default().KnownTag(name) {
+			t.Errorf("%s must be known", name)
+		}
+	}
+	if Default().KnownTag("emial") {
+		t.Error("emial must be unknown")
+	}
+}
+
+func TestCheckTagKeys(t *testing.T) {
+	if issues := CheckTagKeys(`json:"validated" validate:"required" validation_group:"x"`); len(issues) != 0 {
+		t.Errorf("unexpected issues %+v", issues)
+	}
+	if issues := CheckTagKeys(`validation:"required"`); len(issues) != 1 {
+		t.Errorf("expected validation key to be reported, got %+v", issues)
+	}
+	if issues := CheckTagKeys(`validate: "required"`); len(issues) != 1 {
+		t.Errorf("expected space after colon to be reported, got %+v", issues)
+	}
+}
+
+func TestVarConfigErrorCached(t *testing.T) {
+	validate, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := validate.Var("123456", "required,pin=enam")
+	var configError *ConfigError
+	if !errors.As(first, &configError) || len(configError.Issues) != 1 {
+		t.Fatalf("expected config error, got %v", first)
+	}
+	// hasil pengecekan tag yang sama diambil dari cache
+	if second := validate.Var("654321", "required,pin=enam"); second != first {
+		t.Errorf("expected cached config error, got %v", second)
+	}
+	if err := validate.Var("123456", "required,pin=6"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestKnownTagAfterRegister(t *testing.T) {
+	validate, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var configError *ConfigError
+	if err := validate.Var("abc", "kode_cabang"); !errors.As(err, &configError) {
+		t.Fatalf("expected config error, got %v", err)
+	}
+
+	// tag yang di register setelah New langsung dikenal, hasil pengecekan sebelumnya tidak dipakai lagi
+	if err := validate.RegisterValidation("kode_cabang", func(field validator.FieldLevel) bool {
+		return len(field.Field().String()) == 3
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := validate.Var("abc", "kode_cabang"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	validate.RegisterAlias("cabang", "required,kode_cabang")
+	if tags, ok := validate.Alias("cabang"); !validate.KnownTag("cabang") || !ok || tags != "required,kode_cabang" {
+		t.Errorf("expected alias to be known, got %q", tags)
+	}
+	if err := validate.Var("abcd", "cabang"); err == nil {
+		t.Error("expected validation error")
+	}
+}
+
+func TestBuiltinTags(t *testing.T) {
+	validate := validator.New()
+	for name := range builtinTags {
+		func() {
+			// tag bawaan boleh panic karena parameternya kosong, tetapi tidak boleh tidak dikenal
+			defer func() {
+				if r := recover(); r != nil {
+					if message, _ := r.(string); strings.HasPrefix(message, "Undefined validation function") {
+						t.Errorf("%s is not a built-in tag of the validator", name)
+					}
+				}
+			}()
+			_ = validate.Var(nil, name)
+		}()
+	}
+}
@@ -0,0 +1,228 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TagAvailable adalah tag untuk mengecek value belum dipakai, parameternya adalah jenis data, contoh available=email
+const TagAvailable = "available"
+
+// DefaultLookupTimeout adalah batas waktu satu kali pengecekan ke Repository jika WithRepository tidak menentukan timeout
+const DefaultLookupTimeout = 2 * time.Second
+
+// ErrNoRepository adalah Err di LookupError jika tag available digunakan tanpa WithRepository
+// tag tetap valid saat dicek (contoh oleh validatelint), repository baru dibutuhkan saat validasi dijalankan
+var ErrNoRepository = errors.New("validation: no repository configured, use WithRepository")
+
+// Repository adalah sumber data untuk tag available, contoh tabel users di database
+// kind adalah parameter tag, contoh username atau email
+type Repository interface {
+	Taken(ctx context.Context, kind, value string) (bool, error)
+}
+
+// LookupError dikembalikan oleh Struct jika pengecekan ke Repository gagal (error, timeout atau context dibatalkan)
+// error ini bukan kesalahan input user, sehingga tidak dikembalikan sebagai validator.ValidationErrors
+type LookupError struct {
+	Kind  string
+	Value string
+	Err   error
+}
+
+func (e *LookupError) Error() string {
+	return fmt.Sprintf("validation: %s lookup failed: %v", e.Kind, e.Err)
+}
+
+func (e *LookupError) Unwrap() error {
+	return e.Err
+}
+
+// WithRepository mengaktifkan tag available menggunakan repository, timeout adalah batas waktu setiap pengecekan
+// timeout 0 berarti DefaultLookupTimeout
+func WithRepository(repository Repository, timeout time.Duration) Option {
+	return func(o *options) {
+		if timeout <= 0 {
+			timeout = DefaultLookupTimeout
+		}
+		o.repository, o.lookupTimeout = repository, timeout
+	}
+}
+
+type lookupKey struct {
+	kind  string
+	value string
+}
+
+// lookupCache menyimpan hasil pengecekan Repository, hanya hasil yang sukses yang disimpan
+type lookupCache struct {
+	mutex   sync.Mutex
+	results map[lookupKey]bool
+}
+
+type lookupCacheKey struct{}
+
+// WithLookupCache menambahkan cache pengecekan Repository ke ctx, digunakan per request agar value yang sama
+// (contoh email di beberapa struct) hanya dicek sekali. Tanpa cache ini, Struct membuat cache untuk satu kali validasi
+func WithLookupCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, lookupCacheKey{}, &lookupCache{results: map[lookupKey]bool{}})
+}
+
+// lookupCall menyimpan error pengecekan pertama selama satu kali validasi, cache nil jika tidak ada Repository
+type lookupCall struct {
+	cache *lookupCache
+	err   atomic.Pointer[LookupError]
+}
+
+type lookupCallKey struct{}
+
+// startLookups menyiapkan ctx untuk satu kali validasi, cache dari WithLookupCache dipakai jika ada
+func (v *Validator) startLookups(ctx context.Context) (context.Context, *lookupCall) {
+	call := &lookupCall{}
+	if v.repository != nil {
+		cache, ok := ctx.Value(lookupCacheKey{}).(*lookupCache)
+		if !ok {
+			cache = &lookupCache{results: map[lookupKey]bool{}}
+		}
+		call.cache = cache
+	}
+
+	return context.WithValue(ctx, lookupCallKey{}, call), call
+}
+
+// finishLookups mengembalikan LookupError jika ada pengecekan yang gagal, error validasi diabaikan
+// karena field yang gagal dicek dianggap valid dan hasil validasinya tidak lengkap
+func finishLookups(call *lookupCall, err error) error {
+	if call != nil {
+		if lookupError := call.err.Load(); lookupError != nil {
+			return lookupError
+		}
+	}
+
+	return err
+}
+
+// Taken mengecek value sudah dipakai menggunakan Repository dengan timeout dan cache dari ctx
+// bisa dipanggil dari struct level validation yang di register dengan RegisterStructValidationCtx
+// tanpa WithRepository, Taken mengembalikan *LookupError dengan Err ErrNoRepository
+func (v *Validator) Taken(ctx context.Context, kind, value string) (bool, error) {
+	call, _ := ctx.Value(lookupCallKey{}).(*lookupCall)
+	if call == nil {
+		ctx, call = v.startLookups(ctx)
+	}
+
+	if v.repository == nil {
+		lookupError := &LookupError{Kind: kind, Value: value, Err: ErrNoRepository}
+		call.err.CompareAndSwap(nil, lookupError)
+		return false, lookupError
+	}
+
+	key := lookupKey{kind: kind, value: value}
+	call.cache.mutex.Lock()
+	taken, cached := call.cache.results[key]
+	call.cache.mutex.Unlock()
+	if cached {
+		return taken, nil
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, v.lookupTimeout)
+	defer cancel()
+
+	taken, err := v.repository.Taken(lookupCtx, kind, value)
+	if err == nil {
+		err = lookupCtx.Err()
+	}
+	if err != nil {
+		lookupError := &LookupError{Kind: kind, Value: value, Err: err}
+		call.err.CompareAndSwap(nil, lookupError)
+		return false, lookupError
+	}
+
+	call.cache.mutex.Lock()
+	call.cache.results[key] = taken
+	call.cache.mutex.Unlock()
+
+	return taken, nil
+}
+
+// mustBeAvailable adalah validasi tag available, jika pengecekan gagal field dianggap valid dan errornya
+// dikembalikan oleh Struct sebagai LookupError
+func (v *Validator) mustBeAvailable(ctx context.Context, field validator.FieldLevel) bool {
+	value, ok := field.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+
+	taken, err := v.Taken(ctx, field.Param(), value)
+	if err != nil {
+		return true
+	}
+
+	return !taken
+}
+
+// MemoryRepository adalah Repository di memory, digunakan untuk test atau development tanpa database
+type MemoryRepository struct {
+	mutex  sync.RWMutex
+	values map[string]map[string]bool
+	delay  time.Duration
+	calls  atomic.Int64
+}
+
+// NewMemoryRepository membuat MemoryRepository kosong
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{values: map[string]map[string]bool{}}
+}
+
+// Add menandai value sudah dipakai untuk jenis data kind
+func (r *MemoryRepository) Add(kind string, values ...string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.values[kind] == nil {
+		r.values[kind] = map[string]bool{}
+	}
+	for _, value := range values {
+		r.values[kind][value] = true
+	}
+}
+
+// SetDelay mensimulasikan waktu query database, digunakan untuk test timeout dan cancellation
+func (r *MemoryRepository) SetDelay(delay time.Duration) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.delay = delay
+}
+
+// Calls mengembalikan jumlah pemanggilan Taken, digunakan untuk test cache
+func (r *MemoryRepository) Calls() int {
+	return int(r.calls.Load())
+}
+
+// Taken mengecek value sudah ditambahkan dengan Add
+func (r *MemoryRepository) Taken(ctx context.Context, kind, value string) (bool, error) {
+	r.calls.Add(1)
+
+	r.mutex.RLock()
+	delay, taken := r.delay, r.values[kind][value]
+	r.mutex.RUnlock()
+
+	if delay > 0 {
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-ctx.Done():
+			return false, ctx.Err()
+		case <-timer.C:
+		}
+	}
+
+	return taken, nil
+}
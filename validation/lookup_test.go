@@ -0,0 +1,151 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+type signup struct {
+	Username string `json:"username" validate:"required,available=username"`
+	Email    string `json:"email" validate:"required,email,available=email"`
+	Backup   string `json:"backup" validate:"omitempty,email,available=email"`
+}
+
+func newLookupValidator(t *testing.T, timeout time.Duration) (*Validator, *MemoryRepository) {
+	t.Helper()
+
+	repository := NewMemoryRepository()
+	repository.Add("username", "taufik")
+	repository.Add("email", "taufik@email.com")
+
+	validate, err := New(WithRepository(repository, timeout))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return validate, repository
+}
+
+func TestAvailable(t *testing.T) {
+	validate, repository := newLookupValidator(t, 0)
+
+	err := validate.Struct(signup{Username: "taufik", Email: "baru@email.com", Backup: "baru@email.com"})
+	fieldErrors := validate.FieldErrors(err, LocaleID)
+	if len(fieldErrors) != 1 || fieldErrors[0].Path != "username" || fieldErrors[0].Message != "username sudah digunakan" {
+		t.Fatalf("unexpected errors %+v", fieldErrors)
+	}
+	// email dan backup berisi value yang sama, hanya dicek sekali dalam satu kali validasi
+	if repository.Calls() != 2 {
+		t.Errorf("expected 2 lookups, got %d", repository.Calls())
+	}
+
+	// cache per request dipakai bersama oleh beberapa kali validasi
+	ctx := WithLookupCache(context.Background())
+	for range 3 {
+		if err := validate.StructCtx(ctx, signup{Username: "budi", Email: "budi@email.com"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if repository.Calls() != 4 {
+		t.Errorf("expected 4 lookups, got %d", repository.Calls())
+	}
+
+	if err := validate.Var("taufik@email.com", "available=email"); err == nil {
+		t.Error("expected error for taken email")
+	}
+}
+
+func TestAvailableTimeoutAndCancel(t *testing.T) {
+	validate, repository := newLookupValidator(t, 10*time.Millisecond)
+	repository.SetDelay(time.Second)
+
+	err := validate.Struct(signup{Username: "budi", Email: "budi@email.com"})
+	var lookupError *LookupError
+	if !errors.As(err, &lookupError) || !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected timeout lookup error, got %v", err)
+	}
+	if lookupError.Kind != "username" {
+		t.Errorf("unexpected lookup error %+v", lookupError)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := validate.StructCtx(ctx, signup{Username: "budi", Email: "budi@email.com"}); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected canceled lookup error, got %v", err)
+	}
+}
+
+func TestAvailableStructLevel(t *testing.T) {
+	validate, _ := newLookupValidator(t, 0)
+
+	type invite struct {
+		Email string `json:"email" validate:"required,email"`
+	}
+	validate.RegisterStructValidationCtx(func(ctx context.Context, level validator.StructLevel) {
+		email := level.Current().Interface().(invite).Email
+		if taken, err := validate.Taken(ctx, "email", email); err == nil && taken {
+			level.ReportError(email, "email", "Email", TagAvailable, "email")
+		}
+	}, invite{})
+
+	err := validate.Struct(invite{Email: "taufik@email.com"})
+	if fieldErrors := validate.FieldErrors(err); len(fieldErrors) != 1 || fieldErrors[0].Tag != TagAvailable {
+		t.Errorf("unexpected errors %v", err)
+	}
+}
+
+func TestAvailableWithoutRepository(t *testing.T) {
+	validate := Default()
+
+	// tag available tetap valid tanpa repository, sehingga validatelint tidak melaporkannya
+	if err := validate.CheckStructs(signup{}); err != nil {
+		t.Errorf("unexpected config error %v", err)
+	}
+	if issues := validate.LintTag("available=email", nil); len(issues) != 0 {
+		t.Errorf("unexpected issues %+v", issues)
+	}
+	if issues := validate.LintTag("available", nil); len(issues) != 1 {
+		t.Errorf("expected issue for missing parameter, got %+v", issues)
+	}
+
+	err := validate.Struct(signup{Username: "budi", Email: "budi@email.com"})
+	var lookupError *LookupError
+	if !errors.As(err, &lookupError) || !errors.Is(err, ErrNoRepository) || lookupError.Kind != "username" {
+		t.Errorf("expected lookup error, got %v", err)
+	}
+
+	// field tanpa tag available tidak membutuhkan repository
+	if err := validate.Var("budi@email.com", "required,email"); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestAvailablePatch(t *testing.T) {
+	validate, repository := newLookupValidator(t, 0)
+
+	// value berasal dari data yang tersimpan, username tidak dikirim sehingga tidak dicek lagi ke repository
+	value := signup{Username: "taufik", Email: "taufik@email.com"}
+	presence, err := DecodePartial([]byte(`{"email": "baru@email.com"}`), &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validate.Patch(value, presence); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+	if repository.Calls() != 1 {
+		t.Errorf("expected 1 lookup, got %d", repository.Calls())
+	}
+
+	// lookup yang gagal untuk field yang tidak dikirim tidak menggagalkan Patch
+	validate, err = New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validate.Patch(value, Presence{"/backup": true}); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,316 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"belajar-go-lang-validation/validation/generated"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TagType adalah tag error saat value di map tidak sesuai bentuk rule, parameternya object atau array
+const TagType = "type"
+
+// mapRoot adalah nama root namespace untuk MapRules, tidak muncul di FieldError.Path dan Pointer
+const mapRoot = "map"
+
+// MapRule adalah rule untuk value berupa object atau collection object di dalam map[string]any
+//
+//	rules := map[string]any{
+//		"name":    "required",
+//		"address": validation.MapRule{Tag: "required,dive", Fields: map[string]any{"city": "required"}},
+//		"schools": validation.MapRule{Tag: "dive,keys,required,min=2,endkeys", Fields: map[string]any{"name": "required"}},
+//	}
+//
+// Tag ditulis sama seperti tag validate di struct, tag sebelum dive berlaku untuk value itu sendiri,
+// keys..endkeys untuk setiap key map dan tag setelahnya untuk setiap element sebelum Fields divalidasi
+// rule berupa map[string]any sama dengan MapRule tanpa Tag
+type MapRule struct {
+	Tag    string
+	Fields map[string]any
+}
+
+// Map memvalidasi map[string]any menggunakan rule tipe typeName dari rule file, contoh hasil decode JSON tanpa struct
+// error yang dikembalikan sama dengan Struct (validator.ValidationErrors), sehingga FieldErrors tetap bisa digunakan
+func (v *Validator) Map(typeName string, data map[string]any) error {
+	return v.MapCtx(context.Background(), typeName, data)
+}
+
+// MapCtx sama dengan Map dengan context
+func (v *Validator) MapCtx(ctx context.Context, typeName string, data map[string]any) error {
+	rules, ok := v.mapRules[typeName]
+	if !ok {
+		return fmt.Errorf("validation: no rules for type %q, load them with WithRules", typeName)
+	}
+
+	return v.validateMap(ctx, typeName, data, rules)
+}
+
+// MapRules memvalidasi map[string]any termasuk map dan slice map di dalamnya menggunakan rule map dengan bentuk yang sama
+// berbeda dengan validator.Validate.ValidateMap, slice hasil decode JSON ([]any) ikut divalidasi dan lokasi error
+// tetap lengkap, contoh address[0].city dengan pointer /address/0/city
+func (v *Validator) MapRules(data map[string]any, rules map[string]any) error {
+	return v.MapRulesCtx(context.Background(), data, rules)
+}
+
+// MapRulesCtx sama dengan MapRules dengan context
+// jika rule tidak valid (tag tidak dikenal atau tipe rule salah) akan mengembalikan *ConfigError tanpa melakukan validasi
+func (v *Validator) MapRulesCtx(ctx context.Context, data map[string]any, rules map[string]any) error {
+	return v.validateMap(ctx, mapRoot, data, rules)
+}
+
+func (v *Validator) validateMap(ctx context.Context, root string, data map[string]any, rules map[string]any) error {
+	var issues []ConfigIssue
+	v.lintMapRules(root, rules, &issues)
+	if len(issues) > 0 {
+		return &ConfigError{Issues: issues}
+	}
+
+	ctx, lookups := v.startLookups(ctx)
+	m := &mapValidation{v: v, ctx: ctx, limit: errorLimitFrom(ctx)}
+	m.object(root, "", data, rules)
+	if len(m.errors) == 0 {
+		return finishLookups(lookups, nil)
+	}
+
+	return finishLookups(lookups, m.limit.apply(m.errors))
+}
+
+// lintMapRules mengecek seluruh tag di rule map, lokasi issue adalah namespace field
+func (v *Validator) lintMapRules(namespace string, rules map[string]any, issues *[]ConfigIssue) {
+	for _, key := range sortedKeys(rules) {
+		var tag string
+		var fields map[string]any
+		switch rule := rules[key].(type) {
+		case string:
+			tag = rule
+		case map[string]any:
+			fields = rule
+		case MapRule:
+			tag, fields = rule.Tag, rule.Fields
+		default:
+			*issues = append(*issues, ConfigIssue{Struct: namespace, Field: key, Message: fmt.Sprintf("unsupported rule type %T, use a tag string, map[string]any or MapRule", rule)})
+			continue
+		}
+
+		for _, issue := range v.LintTag(tag, nil) {
+			issue.Struct, issue.Field = namespace, key
+			*issues = append(*issues, issue)
+		}
+		v.lintMapRules(namespace+"."+key, fields, issues)
+	}
+}
+
+// mapValidation menyimpan error selama validasi satu map
+type mapValidation struct {
+	v      *Validator
+	ctx    context.Context
+	limit  ErrorLimit
+	errors validator.ValidationErrors
+}
+
+// stopped mengecek error sudah melebihi MaxErrors, error berikutnya pasti dibuang sehingga tidak perlu divalidasi
+func (m *mapValidation) stopped() bool {
+	return m.limit.MaxErrors > 0 && len(m.errors) > m.limit.MaxErrors
+}
+
+// object memvalidasi setiap field di rules, namespace dan pointer adalah lokasi object tersebut
+func (m *mapValidation) object(namespace, pointer string, data map[string]any, rules map[string]any) {
+	for _, key := range sortedKeys(rules) {
+		if m.stopped() {
+			return
+		}
+
+		value := data[key]
+		switch rule := rules[key].(type) {
+		case string:
+			m.value(namespace, pointer+"/"+escapePointer(key), key, value, rule)
+		case map[string]any:
+			m.nested(namespace, pointer, key, value, MapRule{Fields: rule})
+		case MapRule:
+			m.nested(namespace, pointer, key, value, rule)
+		}
+	}
+}
+
+// value menjalankan tag untuk satu value, name adalah nama field (atau field[index] untuk element) dengan lokasi namePointer
+// tag dive hanya dijalankan untuk slice, array dan map, karena validator panic jika dive dipakai pada value lain
+func (m *mapValidation) value(namespace, namePointer, name string, value any, tag string) bool {
+	if tag == "" {
+		return true
+	}
+
+	if _, _, dive := splitDive(tag); dive && value != nil {
+		switch reflect.ValueOf(value).Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+		default:
+			m.typeError(namespace, namePointer, name, value, "array")
+			return false
+		}
+	}
+
+	err := m.v.Validate.VarWithKeyCtx(m.ctx, name, value, tag)
+	validationErrors, ok := m.v.expandErrors(err, reflect.Value{}).(validator.ValidationErrors)
+	if !ok {
+		return true
+	}
+
+	for _, fieldError := range validationErrors {
+		// namespace error dari validator dimulai dari name, sisanya adalah key hasil dive, contoh hobbies[1]
+		m.errors = append(m.errors, &mapFieldError{
+			FieldError: fieldError,
+			namespace:  namespace + "." + fieldError.Namespace(),
+			pointer:    namePointer + keysPointer(strings.TrimPrefix(fieldError.Namespace(), name)),
+		})
+	}
+
+	return false
+}
+
+// nested memvalidasi value berupa object atau collection object sesuai MapRule
+func (m *mapValidation) nested(namespace, pointer, key string, value any, rule MapRule) {
+	fieldNamespace, fieldPointer := namespace+"."+key, pointer+"/"+escapePointer(key)
+	container, rest, dive := splitDive(rule.Tag)
+	if !m.value(namespace, fieldPointer, key, value, strings.Join(container, ",")) || value == nil {
+		return
+	}
+
+	if !dive {
+		object, ok := value.(map[string]any)
+		if !ok {
+			m.typeError(namespace, fieldPointer, key, value, "object")
+			return
+		}
+		m.object(fieldNamespace, fieldPointer, object, rule.Fields)
+		return
+	}
+
+	keys, element := splitKeys(rest)
+	if object, ok := value.(map[string]any); ok {
+		for _, mapKey := range sortedKeys(object) {
+			if m.stopped() {
+				return
+			}
+
+			name, elementPointer := key+"["+mapKey+"]", fieldPointer+"/"+escapePointer(mapKey)
+			if !m.value(namespace, elementPointer, name, mapKey, keys) {
+				continue
+			}
+			m.element(namespace, elementPointer, name, object[mapKey], element, rule.Fields)
+		}
+		return
+	}
+
+	collection := reflect.ValueOf(value)
+	if collection.Kind() != reflect.Slice && collection.Kind() != reflect.Array {
+		m.typeError(namespace, fieldPointer, key, value, "array")
+		return
+	}
+	for i := range collection.Len() {
+		if m.stopped() {
+			return
+		}
+
+		name, elementPointer := key+"["+strconv.Itoa(i)+"]", fieldPointer+"/"+strconv.Itoa(i)
+		m.element(namespace, elementPointer, name, collection.Index(i).Interface(), element, rule.Fields)
+	}
+}
+
+// element memvalidasi satu element collection, tag dijalankan lebih dulu lalu fields jika element berupa object
+func (m *mapValidation) element(namespace, pointer, name string, value any, tag []string, fields map[string]any) {
+	if !m.value(namespace, pointer, name, value, strings.Join(tag, ",")) || value == nil || fields == nil {
+		return
+	}
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		m.typeError(namespace, pointer, name, value, "object")
+		return
+	}
+	m.object(namespace+"."+name, pointer, object, fields)
+}
+
+// typeError menambahkan error TagType, contoh address berupa string padahal rule mengharapkan array
+func (m *mapValidation) typeError(namespace, pointer, name string, value any, param string) {
+	var errs generated.Errors
+	root := generated.Root(namespace)
+	field := root.Field(name, name)
+	errs.Add(&field, TagType, TagType, param, value)
+
+	for _, fieldError := range errs.Err().(validator.ValidationErrors) {
+		m.errors = append(m.errors, &mapFieldError{FieldError: fieldError, namespace: namespace + "." + name, pointer: pointer})
+	}
+}
+
+// keysPointer mengubah key hasil dive menjadi JSON pointer, contoh [1][BCA] menjadi /1/BCA
+func keysPointer(keys string) string {
+	if keys == "" {
+		return ""
+	}
+
+	var pointer strings.Builder
+	for _, key := range strings.Split(strings.TrimSuffix(strings.TrimPrefix(keys, "["), "]"), "][") {
+		pointer.WriteString("/" + escapePointer(key))
+	}
+
+	return pointer.String()
+}
+
+// splitDive memisahkan tag sebelum dan sesudah dive pertama, contoh required,dive,required
+func splitDive(tag string) (container, rest []string, dive bool) {
+	if tag == "" {
+		return nil, nil, false
+	}
+
+	tokens := strings.Split(tag, ",")
+	for i, token := range tokens {
+		if strings.TrimSpace(token) == "dive" {
+			return tokens[:i], tokens[i+1:], true
+		}
+	}
+
+	return tokens, nil, false
+}
+
+// splitKeys memisahkan tag keys..endkeys dari tag element, contoh keys,required,min=2,endkeys,required
+func splitKeys(tokens []string) (keys string, element []string) {
+	if len(tokens) == 0 || strings.TrimSpace(tokens[0]) != "keys" {
+		return "", tokens
+	}
+
+	for i, token := range tokens {
+		if strings.TrimSpace(token) == "endkeys" {
+			return strings.Join(tokens[1:i], ","), tokens[i+1:]
+		}
+	}
+
+	return strings.Join(tokens[1:], ","), nil
+}
+
+// mapFieldError adalah error dari Map dan MapRules dengan namespace lengkap dan lokasi dalam bentuk JSON pointer
+type mapFieldError struct {
+	validator.FieldError
+	namespace string
+	pointer   string
+}
+
+func (e *mapFieldError) Namespace() string {
+	return e.namespace
+}
+
+func (e *mapFieldError) StructNamespace() string {
+	return e.namespace
+}
+
+// Pointer mengembalikan lokasi field dalam bentuk JSON pointer, dipakai oleh FieldErrors
+func (e *mapFieldError) Pointer() string {
+	return e.pointer
+}
+
+func (e *mapFieldError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.namespace, e.Field(), e.Tag())
+}
@@ -0,0 +1,135 @@
+package validation
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// userRules adalah rule map dengan bentuk yang sama dengan tag validate model.User
+var userRules = map[string]any{
+	"id":      "required",
+	"name":    "required",
+	"address": MapRule{Tag: "required,dive", Fields: map[string]any{"city": "required", "country": "required"}},
+	"hobbies": "required,dive,required,min=3",
+	"schools": MapRule{Tag: "dive,keys,required,min=2,endkeys", Fields: map[string]any{"name": "required"}},
+	"wallets": "dive,keys,required,bank_code,endkeys,required,gt=1000",
+	"profile": map[string]any{"bio": "max=10"},
+}
+
+func decodeMap(t *testing.T, data string) map[string]any {
+	t.Helper()
+
+	var value map[string]any
+	if err := json.Unmarshal([]byte(data), &value); err != nil {
+		t.Fatal(err)
+	}
+
+	return value
+}
+
+func TestMapRulesNested(t *testing.T) {
+	validate := Default()
+
+	valid := decodeMap(t, `{
+		"id": "1", "name": "Taufik",
+		"address": [{"city": "Malang", "country": "Indonesia"}],
+		"hobbies": ["Coding"],
+		"schools": {"SMP": {"name": "SMP Negeri 1"}},
+		"wallets": {"BCA": 150000},
+		"profile": {"bio": "halo"}
+	}`)
+	if err := validate.MapRules(valid, userRules); err != nil {
+		t.Fatalf("expected valid user, got %v", err)
+	}
+
+	invalid := decodeMap(t, `{
+		"id": "1",
+		"address": [{"city": "Malang", "country": "Indonesia"}, {"city": ""}, "Jakarta"],
+		"hobbies": ["Coding", "TV"],
+		"schools": {"S": {"name": "SD"}, "SMA/K": {}},
+		"wallets": {"XYZ": 100},
+		"profile": {"bio": "terlalu panjang"}
+	}`)
+	fieldErrors := validate.FieldErrors(validate.MapRules(invalid, userRules))
+
+	expected := []struct{ path, pointer, tag string }{
+		{"address[1].city", "/address/1/city", "required"},
+		{"address[1].country", "/address/1/country", "required"},
+		{"address[2]", "/address/2", TagType},
+		{"hobbies[1]", "/hobbies/1", "min"},
+		{"name", "/name", "required"},
+		{"profile.bio", "/profile/bio", "max"},
+		{`schools["S"]`, "/schools/S", "min"},
+		{`schools["SMA/K"].name`, "/schools/SMA~1K/name", "required"},
+		{`wallets["XYZ"]`, "/wallets/XYZ", TagBankCode},
+		{`wallets["XYZ"]`, "/wallets/XYZ", "gt"},
+	}
+	if len(fieldErrors) != len(expected) {
+		t.Fatalf("expected %d errors, got %+v", len(expected), fieldErrors)
+	}
+	for i, want := range expected {
+		got := fieldErrors[i]
+		if got.Path != want.path || got.Pointer != want.pointer || got.Tag != want.tag {
+			t.Errorf("error %d: expected %+v, got %+v", i, want, got)
+		}
+	}
+	if fieldErrors[2].Message != "address[2] must be an object" {
+		t.Errorf("unexpected message %q", fieldErrors[2].Message)
+	}
+}
+
+func TestMapRulesTypeMismatch(t *testing.T) {
+	validate := Default()
+
+	data := decodeMap(t, `{"address": "Malang", "hobbies": "Coding", "profile": []}`)
+	fieldErrors := validate.FieldErrors(validate.MapRules(data, map[string]any{
+		"address": userRules["address"],
+		"hobbies": userRules["hobbies"],
+		"profile": userRules["profile"],
+	}), LocaleID)
+
+	expected := map[string]string{
+		"/address": "address harus berupa array",
+		"/hobbies": "hobbies harus berupa array",
+		"/profile": "profile harus berupa object",
+	}
+	if len(fieldErrors) != len(expected) {
+		t.Fatalf("unexpected errors %+v", fieldErrors)
+	}
+	for _, fieldError := range fieldErrors {
+		if expected[fieldError.Pointer] != fieldError.Message {
+			t.Errorf("unexpected error %+v", fieldError)
+		}
+	}
+}
+
+func TestMapRulesConfigError(t *testing.T) {
+	err := Default().MapRules(map[string]any{}, map[string]any{
+		"name":    "required,short",
+		"address": MapRule{Tag: "dive", Fields: map[string]any{"city": 1}},
+	})
+
+	var configError *ConfigError
+	if !errors.As(err, &configError) || len(configError.Issues) != 2 {
+		t.Fatalf("expected 2 config issues, got %v", err)
+	}
+	if issue := configError.Issues[0]; issue.Struct != "map.address" || issue.Field != "city" {
+		t.Errorf("unexpected issue %+v", issue)
+	}
+}
+
+func TestStructErrorPointer(t *testing.T) {
+	for namespace, pointer := range map[string]string{
+		"User.name":                "/name",
+		"User.address[0].city":     "/address/0/city",
+		"User.schools[SMP].name":   "/schools/SMP/name",
+		"User.wallets[a~b]":        "/wallets/a~0b",
+		"User.matrix[1][2]":        "/matrix/1/2",
+		"RegisterRequest.username": "/username",
+	} {
+		if got := namespacePointer(trimRoot(namespace)); got != pointer {
+			t.Errorf("%s: expected %s, got %s", namespace, pointer, got)
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package validation
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TagNIK adalah tag untuk validasi Nomor Induk Kependudukan
+const TagNIK = "nik"
+
+// error yang dikembalikan ParseNIK, bisa dicek menggunakan errors.Is
+var (
+	ErrNIKFormat    = errors.New("nik must be 16 digits")
+	ErrNIKRegion    = errors.New("nik region code is unknown")
+	ErrNIKBirthDate = errors.New("nik birth date is not a valid date")
+	ErrNIKSequence  = errors.New("nik sequence number must not be zero")
+)
+
+//go:embed data/regions.csv
+var regionsCSV []byte
+
+// Regions adalah tabel kode wilayah (provinsi, kabupaten/kota dan kecamatan) untuk validasi NIK
+//
+// tabel boleh tidak lengkap, jika sebuah provinsi tidak memiliki data kabupaten/kota
+// (atau kabupaten/kota tidak memiliki data kecamatan) maka level tersebut hanya dicek formatnya saja
+type Regions struct {
+	names    map[string]string
+	children map[string]bool
+}
+
+var defaultRegions = mustLoadRegions(regionsCSV)
+
+// DefaultRegions mengembalikan tabel wilayah bawaan yang di embed di package ini
+//
+// tabel bawaan hanya berisi 38 kode provinsi, kode kabupaten/kota dan kecamatan hanya dicek formatnya (bukan 00),
+// sehingga NIK dengan kabupaten/kota atau kecamatan yang tidak ada (contoh 35.99 atau 35.10.99) tetap valid.
+// Gunakan LoadRegions dan WithRegions dengan tabel dari Kemendagri jika kabupaten/kota dan kecamatan juga perlu dicek
+func DefaultRegions() *Regions {
+	return defaultRegions
+}
+
+// LoadRegions membaca tabel wilayah dengan format "kode,nama" per baris, contoh "35.10,Kabupaten Banyuwangi"
+// baris kosong dan baris yang diawali '#' akan diabaikan
+func LoadRegions(reader io.Reader) (*Regions, error) {
+	regions := &Regions{names: map[string]string{}, children: map[string]bool{}}
+
+	scanner := bufio.NewScanner(reader)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		code, name, ok := strings.Cut(text, ",")
+		if !ok || !validRegionCode(code) {
+			return nil, fmt.Errorf("regions: invalid entry %q on line %d", text, line)
+		}
+
+		regions.names[code] = strings.TrimSpace(name)
+		if i := strings.LastIndexByte(code, '.'); i >= 0 {
+			regions.children[code[:i]] = true
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return regions, nil
+}
+
+func mustLoadRegions(data []byte) *Regions {
+	regions, err := LoadRegions(bytes.NewReader(data))
+	if err != nil {
+		panic(err)
+	}
+
+	return regions
+}
+
+// validRegionCode memastikan kode berbentuk 2 digit yang dipisah titik, maksimal 3 level
+func validRegionCode(code string) bool {
+	parts := strings.Split(code, ".")
+	if len(parts) > 3 {
+		return false
+	}
+	for _, part := range parts {
+		if len(part) != 2 || !regexNumber.MatchString(part) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Name mengembalikan nama wilayah dari kode, contoh "35.10"
+func (r *Regions) Name(code string) (string, bool) {
+	name, ok := r.names[code]
+	return name, ok
+}
+
+// contains mengecek kode wilayah, level yang tidak memiliki data di tabel dianggap valid
+func (r *Regions) contains(code string) bool {
+	if _, ok := r.names[code]; ok {
+		return true
+	}
+
+	i := strings.LastIndexByte(code, '.')
+	if i < 0 {
+		return false
+	}
+
+	parent := code[:i]
+	return !r.children[parent] && r.contains(parent)
+}
+
+// NIK adalah hasil parsing Nomor Induk Kependudukan
+type NIK struct {
+	Province  string
+	Regency   string
+	District  string
+	BirthDate time.Time
+	Female    bool
+	Sequence  int
+}
+
+// ParseNIK memecah dan mengecek NIK 16 digit, formatnya PPKKCC DDMMYY SSSS
+// untuk perempuan tanggal lahir ditambah 40, regions nil berarti menggunakan DefaultRegions
+func ParseNIK(value string, regions *Regions) (NIK, error) {
+	if regions == nil {
+		regions = defaultRegions
+	}
+
+	if len(value) != 16 || !regexNumber.MatchString(value) {
+		return NIK{}, ErrNIKFormat
+	}
+
+	nik := NIK{
+		Province: value[0:2],
+		Regency:  value[0:2] + "." + value[2:4],
+		District: value[0:2] + "." + value[2:4] + "." + value[4:6],
+	}
+	if value[2:4] == "00" || value[4:6] == "00" || !regions.contains(nik.District) {
+		return NIK{}, ErrNIKRegion
+	}
+
+	day, _ := strconv.Atoi(value[6:8])
+	month, _ := strconv.Atoi(value[8:10])
+	year, _ := strconv.Atoi(value[10:12])
+	if day > 40 {
+		day -= 40
+		nik.Female = true
+	}
+
+	birthDate, ok := birthDate(day, month, year, time.Now())
+	if !ok {
+		return NIK{}, ErrNIKBirthDate
+	}
+	nik.BirthDate = birthDate
+
+	nik.Sequence, _ = strconv.Atoi(value[12:16])
+	if nik.Sequence == 0 {
+		return NIK{}, ErrNIKSequence
+	}
+
+	return nik, nil
+}
+
+// birthDate menentukan abad dari tahun 2 digit, tahun 20YY dipakai jika tanggalnya tidak melewati hari ini
+// tanggal dianggap valid jika tidak berubah setelah dibuat dengan time.Date, contoh 31 Februari akan berubah jadi Maret
+func birthDate(day, month, year int, now time.Time) (time.Time, bool) {
+	date := time.Date(2000+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if date.After(now) {
+		date = time.Date(1900+year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	}
+
+	valid := date.Day() == day && int(date.Month()) == month && date.Year()%100 == year
+	return date, valid
+}
+
+// MustValidNIK memastikan value adalah NIK yang valid menggunakan DefaultRegions
+func MustValidNIK(field validator.FieldLevel) bool {
+	return validNIK(defaultRegions)(field)
+}
+
+// validNIK membuat fungsi validasi NIK dengan tabel wilayah tertentu
+func validNIK(regions *Regions) validator.Func {
+	return func(field validator.FieldLevel) bool {
+		value, ok := field.Field().Interface().(string)
+		if !ok {
+			return false
+		}
+
+		_, err := ParseNIK(value, regions)
+		return err == nil
+	}
+}
+
+// WithRegions mengganti tabel wilayah yang dipakai tag nik dan tag npwp untuk NPWP berupa NIK, contoh tabel lengkap dari Kemendagri
+func WithRegions(regions *Regions) Option {
+	return func(o *options) {
+		o.regions = regions
+	}
+}
@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseNIK(t *testing.T) {
+	nik, err := ParseNIK("3510015708950001", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !nik.Female || nik.Regency != "35.10" || nik.Sequence != 1 {
+		t.Errorf("unexpected nik %+v", nik)
+	}
+	if !nik.BirthDate.Equal(time.Date(1995, time.August, 17, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected birth date %v", nik.BirthDate)
+	}
+
+	invalid := map[string]error{
+		"351001170895000":  ErrNIKFormat,
+		"35100117089500a1": ErrNIKFormat,
+		"9910011708950001": ErrNIKRegion,
+		"3510001708950001": ErrNIKRegion,
+		"3510013102950001": ErrNIKBirthDate,
+		"3510017102950001": ErrNIKBirthDate,
+		"3510011708950000": ErrNIKSequence,
+	}
+	for value, expected := range invalid {
+		if _, err := ParseNIK(value, nil); !errors.Is(err, expected) {
+			t.Errorf("%s: expected %v, got %v", value, expected, err)
+		}
+	}
+}
+
+func TestNIKTagWithRegions(t *testing.T) {
+	regions, err := LoadRegions(strings.NewReader("35,Jawa Timur\n35.10,Kabupaten Banyuwangi\n35.10.01,Pesanggaran\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validate, err := New(WithRegions(regions))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validate.Var("3510011708950001", "required,nik"); err != nil {
+		t.Errorf("expected valid nik, got %v", err)
+	}
+	if err := validate.Var("3510021708950001", "required,nik"); err == nil {
+		t.Error("expected unknown district to fail")
+	}
+	if err := Default().Var("3510021708950001", "required,nik"); err != nil {
+		t.Errorf("default regions have no districts, got %v", err)
+	}
+
+	// NPWP 16 digit berupa NIK memakai tabel wilayah yang sama
+	if err := validate.Var("3510021708950001", "required,npwp"); err == nil {
+		t.Error("expected npwp with unknown district to fail")
+	}
+	if err := validate.Var("3510011708950001", "required,npwp"); err != nil {
+		t.Errorf("expected valid npwp, got %v", err)
+	}
+
+	// tabel bawaan hanya berisi provinsi, kabupaten/kota 35.99 dan kecamatan 35.10.99 hanya terdeteksi dengan tabel lengkap
+	for _, value := range []string{"3510991708950001", "3599011708950001"} {
+		if _, err := ParseNIK(value, nil); err != nil {
+			t.Errorf("%s: default regions only check the regency and district format, got %v", value, err)
+		}
+	}
+	if _, err := ParseNIK("3510991708950001", regions); !errors.Is(err, ErrNIKRegion) {
+		t.Errorf("expected %v for unknown district, got %v", ErrNIKRegion, err)
+	}
+	if _, err := ParseNIK("9910011708950001", nil); !errors.Is(err, ErrNIKRegion) {
+		t.Errorf("expected %v for unknown province, got %v", ErrNIKRegion, err)
+	}
+	if _, err := ParseNIK("3599011708950001", regions); !errors.Is(err, ErrNIKRegion) {
+		t.Errorf("expected %v for unknown regency, got %v", ErrNIKRegion, err)
+	}
+
+	if _, err := LoadRegions(strings.NewReader("351,Salah\n")); err == nil {
+		t.Error("expected invalid region code to fail")
+	}
+}
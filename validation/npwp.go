@@ -0,0 +1,99 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TagNPWP adalah tag untuk validasi Nomor Pokok Wajib Pajak
+const TagNPWP = "npwp"
+
+// error yang dikembalikan NormalizeNPWP, bisa dicek menggunakan errors.Is
+var (
+	ErrNPWPFormat   = errors.New("npwp must be 15 or 16 digits")
+	ErrNPWPChecksum = errors.New("npwp checksum digit is invalid")
+)
+
+// NormalizeNPWP menghapus tanda baca dan mengubah NPWP menjadi format baru 16 digit
+//
+// NPWP lama 15 digit (xx.xxx.xxx.x-xxx.xxx) diubah menjadi 16 digit dengan menambahkan 0 di depan,
+// digit ke 9 format lama adalah check digit (algoritma luhn) dari 8 digit pertama.
+// NPWP 16 digit yang tidak diawali 0 adalah NIK, sehingga dicek menggunakan ParseNIK dengan DefaultRegions
+func NormalizeNPWP(value string) (string, error) {
+	return normalizeNPWP(value, nil)
+}
+
+// normalizeNPWP sama dengan NormalizeNPWP, NPWP berupa NIK dicek dengan tabel wilayah regions
+func normalizeNPWP(value string, regions *Regions) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case '.', '-', ' ':
+			return -1
+		}
+		return r
+	}, value)
+
+	if !regexNumber.MatchString(digits) {
+		return "", ErrNPWPFormat
+	}
+
+	switch len(digits) {
+	case 15:
+		digits = "0" + digits
+	case 16:
+		if digits[0] != '0' {
+			if _, err := ParseNIK(digits, regions); err != nil {
+				return "", err
+			}
+			return digits, nil
+		}
+	default:
+		return "", ErrNPWPFormat
+	}
+
+	if !luhnValid(digits[1:10]) {
+		return "", ErrNPWPChecksum
+	}
+
+	return digits, nil
+}
+
+// luhnValid mengecek deretan angka yang digit terakhirnya adalah check digit luhn
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		digit := int(digits[i] - '0')
+		if double {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// MustValidNPWP memastikan value adalah NPWP 15 digit (boleh dengan tanda baca) atau 16 digit yang valid
+// NPWP berupa NIK dicek menggunakan DefaultRegions
+func MustValidNPWP(field validator.FieldLevel) bool {
+	return validNPWP(defaultRegions)(field)
+}
+
+// validNPWP membuat fungsi validasi NPWP dengan tabel wilayah tertentu untuk NPWP berupa NIK
+func validNPWP(regions *Regions) validator.Func {
+	return func(field validator.FieldLevel) bool {
+		value, ok := field.Field().Interface().(string)
+		if !ok {
+			return false
+		}
+
+		_, err := normalizeNPWP(value, regions)
+		return err == nil
+	}
+}
@@ -0,0 +1,49 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNormalizeNPWP(t *testing.T) {
+	valid := map[string]string{
+		"01.234.567.4-627.000": "0012345674627000",
+		"012345674627000":      "0012345674627000",
+		"0012345674627000":     "0012345674627000",
+		"3510011708950001":     "3510011708950001",
+	}
+	for value, expected := range valid {
+		normalized, err := NormalizeNPWP(value)
+		if err != nil || normalized != expected {
+			t.Errorf("%s: expected %s, got %s (%v)", value, expected, normalized, err)
+		}
+	}
+
+	invalid := map[string]error{
+		"01.234.567.5-627.000": ErrNPWPChecksum,
+		"01.234.567.4-627.00":  ErrNPWPFormat,
+		"01.234.567.4/627.000": ErrNPWPFormat,
+		"9910011708950001":     ErrNIKRegion,
+	}
+	for value, expected := range invalid {
+		if _, err := NormalizeNPWP(value); !errors.Is(err, expected) {
+			t.Errorf("%s: expected %v, got %v", value, expected, err)
+		}
+	}
+}
+
+func TestNPWPTag(t *testing.T) {
+	type Company struct {
+		NPWP string `json:"npwp" validate:"required,npwp"`
+	}
+
+	if err := Default().Struct(Company{NPWP: "01.234.567.4-627.000"}); err != nil {
+		t.Errorf("expected valid npwp, got %v", err)
+	}
+
+	err := Default().Struct(Company{NPWP: "01.234.567.5-627.000"})
+	messages := Default().Translate(err, LocaleID)
+	if messages["Company.npwp"] != "npwp harus berupa NPWP 15 digit (xx.xxx.xxx.x-xxx.xxx) atau 16 digit yang valid" {
+		t.Errorf("unexpected messages %v", messages)
+	}
+}
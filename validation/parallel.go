@@ -0,0 +1,436 @@
+package validation
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// DefaultParallelMinItems adalah jumlah element minimal agar collection divalidasi secara paralel
+const DefaultParallelMinItems = 1000
+
+// parallelChunk adalah jumlah element yang diambil worker sekaligus, agar overhead sinkronisasi kecil
+const parallelChunk = 64
+
+// ParallelOptions mengatur StructParallel
+type ParallelOptions struct {
+	// Workers adalah jumlah goroutine maksimal per collection, default runtime.GOMAXPROCS(0)
+	Workers int
+	// MinItems adalah jumlah element minimal agar collection divalidasi paralel, default DefaultParallelMinItems
+	// collection yang lebih kecil divalidasi biasa karena biaya goroutine lebih besar dari keuntungannya
+	MinItems int
+}
+
+// StructParallel sama dengan StructCtx, tetapi element slice, array dan map dengan tag dive di field struct paling luar
+// divalidasi secara paralel menggunakan worker pool, contoh untuk import ratusan ribu Address
+//
+// urutan error tetap sama setiap kali dipanggil: berdasarkan urutan field, lalu index slice atau key map yang diurutkan
+// jika ctx dibatalkan, validasi berhenti dan ctx.Err() dikembalikan. Dengan WithErrorLimit, element berikutnya tidak
+// divalidasi setelah batasnya terlampaui. Collection yang tag nya membandingkan dengan field lain (contoh eqfield atau
+// eqcsfield) tetap divalidasi biasa, karena element yang divalidasi terpisah tidak memiliki parent
+func (v *Validator) StructParallel(ctx context.Context, s any, opts ParallelOptions) error {
+	if opts.Workers <= 0 {
+		opts.Workers = runtime.GOMAXPROCS(0)
+	}
+	if opts.MinItems <= 0 {
+		opts.MinItems = DefaultParallelMinItems
+	}
+
+	return v.structSplit(ctx, s, opts.Workers, opts.MinItems)
+}
+
+// structSplit memvalidasi element collection di field struct paling luar yang panjangnya minimal minItems secara
+// terpisah menggunakan workers goroutine, field lain divalidasi sekaligus dengan StructExceptCtx
+func (v *Validator) structSplit(ctx context.Context, s any, workers, minItems int) error {
+	if err := v.checkType(reflect.TypeOf(s)); err != nil {
+		return err
+	}
+
+	root := reflect.ValueOf(s)
+	current := indirect(root)
+	if current.Kind() != reflect.Struct {
+		return v.structCtx(ctx, s)
+	}
+
+	typ := current.Type()
+	var fields []parallelField
+	var excluded []string
+	for _, split := range v.splitPlan(typ) {
+		value := indirect(current.Field(split.index))
+		if !value.IsValid() {
+			continue
+		}
+
+		switch value.Kind() {
+		case reflect.Slice, reflect.Array, reflect.Map:
+		default:
+			continue
+		}
+		if value.Len() < minItems {
+			continue
+		}
+
+		field := split
+		field.value = value
+		fields = append(fields, field)
+		excluded = append(excluded, field.goName)
+	}
+
+	if len(fields) == 0 {
+		return v.structCtx(ctx, s)
+	}
+
+	ctx, lookups := v.startLookups(ctx)
+
+	// field lain (termasuk struct level validation) divalidasi biasa, error dikelompokkan per field agar urutannya tetap
+	groups := make([]validator.ValidationErrors, typ.NumField()+1)
+	if err := v.Validate.StructExceptCtx(ctx, s, excluded...); err != nil {
+		validationErrors, ok := err.(validator.ValidationErrors)
+		if !ok {
+			return err
+		}
+		for _, fieldError := range validationErrors {
+			index := fieldIndex(typ, fieldError.Namespace())
+			groups[index] = append(groups[index], fieldError)
+		}
+	}
+
+	limit := errorLimitFrom(ctx)
+	job := parallelJob{v: v, ctx: ctx, namespace: typ.Name(), workers: workers, chunk: parallelChunk}
+	if workers == 1 {
+		job.chunk = 1
+	}
+	for _, field := range fields {
+		job.bound = -1
+		if limit.enabled() {
+			var before validator.ValidationErrors
+			for _, group := range groups[:field.index+1] {
+				before = append(before, group...)
+			}
+			job.bound = limit.collectionBound(len(limit.keep(before)))
+		}
+
+		fieldErrors, err := job.field(field)
+		if err != nil {
+			return err
+		}
+		groups[field.index] = append(groups[field.index], fieldErrors...)
+	}
+
+	var result validator.ValidationErrors
+	for _, group := range groups {
+		result = append(result, group...)
+	}
+	if len(result) == 0 {
+		return finishLookups(lookups, nil)
+	}
+
+	// element yang tidak divalidasi hanya ada jika error collection melebihi bound, sehingga apply pasti membuang error
+	return finishLookups(lookups, limit.apply(v.expandErrors(result, root)))
+}
+
+// splitPlan mengembalikan field collection dengan tag dive yang elementnya bisa divalidasi terpisah, di cache per tipe
+// tag dari rule file dipakai jika ada, sama dengan yang dijalankan validator
+func (v *Validator) splitPlan(typ reflect.Type) []parallelField {
+	if cached, ok := v.splitFields.Load(typ); ok {
+		return cached.([]parallelField)
+	}
+
+	var plan []parallelField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, ok := v.fieldRules[typ][field.Name]
+		if !ok {
+			tag = field.Tag.Get(ValidateTagKey)
+		}
+
+		container, rest, dive := splitDive(tag)
+		if !field.IsExported() || !dive {
+			continue
+		}
+
+		keys, element := splitKeys(rest)
+		elementTag := strings.Join(element, ",")
+		if v.referencesFields(keys) || v.referencesFields(elementTag) || v.referencesTopLevel(field.Type, map[reflect.Type]bool{}) {
+			continue
+		}
+
+		plan = append(plan, parallelField{
+			index:     i,
+			name:      FieldName(field),
+			goName:    field.Name,
+			container: strings.Join(container, ","),
+			keys:      keys,
+			element:   elementTag,
+		})
+	}
+
+	v.splitFields.Store(typ, plan)
+	return plan
+}
+
+// referencesFields mengecek tag membandingkan dengan field lain, contoh eqfield=Password atau eqcsfield=User.Name
+func (v *Validator) referencesFields(tag string) bool {
+	if _, ok := v.crossFieldTag(tag); ok {
+		return true
+	}
+
+	return v.referencesTopLevelTag(tag)
+}
+
+// referencesTopLevelTag mengecek tag cs (contoh eqcsfield) yang membandingkan dengan field dari struct paling luar
+func (v *Validator) referencesTopLevelTag(tag string) bool {
+	for _, token := range splitTag(tag) {
+		name, _, _ := strings.Cut(token, "=")
+		if expanded, ok := v.aliases[name]; ok {
+			if v.referencesTopLevelTag(expanded) {
+				return true
+			}
+			continue
+		}
+		if strings.HasSuffix(name, "csfield") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// referencesTopLevel mengecek struct di dalam element memakai tag cs, field yang dibandingkan dicari dari struct
+// paling luar sehingga element tersebut tidak bisa divalidasi terpisah
+func (v *Validator) referencesTopLevel(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	typ = elementType(typ)
+	if typ.Kind() != reflect.Struct || visited[typ] {
+		return false
+	}
+	visited[typ] = true
+
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		tag, ok := v.fieldRules[typ][field.Name]
+		if !ok {
+			tag = field.Tag.Get(ValidateTagKey)
+		}
+		if v.referencesTopLevelTag(tag) || v.referencesTopLevel(field.Type, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// parallelField adalah field struct paling luar yang elementnya divalidasi paralel, tag sudah dipecah sekitar dive
+type parallelField struct {
+	index     int
+	name      string
+	goName    string
+	value     reflect.Value
+	container string
+	keys      string
+	element   string
+}
+
+// fieldIndex mencari index field struct dari namespace error, contoh User.address[0].city menjadi index field Address
+// error yang tidak bisa dicocokkan (contoh ReportError dengan nama lain) diletakkan di akhir
+func fieldIndex(typ reflect.Type, namespace string) int {
+	name := trimRoot(namespace)
+	if i := strings.IndexAny(name, ".["); i >= 0 {
+		name = name[:i]
+	}
+
+	for i := 0; i < typ.NumField(); i++ {
+		if FieldName(typ.Field(i)) == name {
+			return i
+		}
+	}
+
+	return typ.NumField()
+}
+
+// parallelJob menjalankan validasi element satu field menggunakan worker pool
+type parallelJob struct {
+	v         *Validator
+	ctx       context.Context
+	namespace string
+	workers   int
+	chunk     int
+	// bound adalah jumlah error element yang boleh dikembalikan, -1 berarti tidak dibatasi
+	bound int
+}
+
+// field memvalidasi tag sebelum dive lalu seluruh element, mengembalikan ctx.Err() jika ctx dibatalkan
+// jika error element di awal collection melebihi bound, element berikutnya tidak divalidasi
+func (j parallelJob) field(field parallelField) (validator.ValidationErrors, error) {
+	if field.container != "" {
+		if fieldErrors := j.validate(field.name, field.goName, field.value.Interface(), field.container); len(fieldErrors) > 0 {
+			return fieldErrors, nil
+		}
+	}
+
+	var keys []reflect.Value
+	if field.value.Kind() == reflect.Map {
+		keys = field.value.MapKeys()
+		sort.Slice(keys, func(a, b int) bool {
+			return keyString(keys[a]) < keyString(keys[b])
+		})
+	}
+
+	length := field.value.Len()
+	results := make([]validator.ValidationErrors, length)
+	progress := newChunkProgress((length+j.chunk-1)/j.chunk, j.bound)
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range min(j.workers, len(progress.counts)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j.ctx.Err() == nil && !progress.stopped.Load() {
+				chunk := int(next.Add(1)) - 1
+				if chunk >= len(progress.counts) {
+					return
+				}
+
+				count := 0
+				for i := chunk * j.chunk; i < min((chunk+1)*j.chunk, length); i++ {
+					results[i] = j.element(field, keys, i)
+					count += len(results[i])
+				}
+				progress.done(chunk, count)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if err := j.ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var fieldErrors validator.ValidationErrors
+	for _, result := range results {
+		fieldErrors = append(fieldErrors, result...)
+	}
+
+	return fieldErrors, nil
+}
+
+// chunkProgress menghitung error chunk yang sudah selesai secara berurutan dari awal collection, sehingga berhenti
+// lebih awal tidak mengubah error yang dikembalikan setelah dibatasi ErrorLimit
+type chunkProgress struct {
+	mutex    sync.Mutex
+	counts   []int
+	finished int
+	errors   int
+	bound    int
+	stopped  atomic.Bool
+}
+
+func newChunkProgress(chunks, bound int) *chunkProgress {
+	counts := make([]int, chunks)
+	for i := range counts {
+		counts[i] = -1
+	}
+
+	return &chunkProgress{counts: counts, bound: bound}
+}
+
+// done mencatat jumlah error chunk, stopped menjadi true jika error chunk awal yang sudah selesai melebihi bound
+// sehingga stopped hanya bernilai true jika ErrorLimit pasti membuang error
+func (p *chunkProgress) done(chunk, count int) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.counts[chunk] = count
+	for p.finished < len(p.counts) && p.counts[p.finished] >= 0 {
+		p.errors += p.counts[p.finished]
+		p.finished++
+	}
+	if p.bound >= 0 && p.errors > p.bound && p.finished < len(p.counts) {
+		p.stopped.Store(true)
+	}
+}
+
+// element memvalidasi satu element slice (index i) atau map (key ke i dari keys yang sudah diurutkan)
+// seperti validator, value map tetap divalidasi walaupun key nya tidak valid
+func (j parallelJob) element(field parallelField, keys []reflect.Value, i int) validator.ValidationErrors {
+	var value reflect.Value
+	var suffix string
+	var fieldErrors validator.ValidationErrors
+	if keys != nil {
+		key := keys[i]
+		value, suffix = field.value.MapIndex(key), "["+keyString(key)+"]"
+		if field.keys != "" {
+			fieldErrors = j.validate(field.name+suffix, field.goName+suffix, key.Interface(), field.keys)
+		}
+	} else {
+		value, suffix = field.value.Index(i), "["+strconv.Itoa(i)+"]"
+	}
+
+	if field.element != "" {
+		return append(fieldErrors, j.validate(field.name+suffix, field.goName+suffix, value.Interface(), field.element)...)
+	}
+
+	// element struct tanpa tag tetap divalidasi, sama seperti dive di validator
+	element := indirect(value)
+	if element.Kind() != reflect.Struct {
+		return fieldErrors
+	}
+	err := j.v.Validate.StructCtx(j.ctx, element.Interface())
+	validationErrors, _ := err.(validator.ValidationErrors)
+	for _, fieldError := range validationErrors {
+		fieldErrors = append(fieldErrors, j.prefix(fieldError, element.Type().Name(), field.name+suffix, field.goName+suffix))
+	}
+
+	return fieldErrors
+}
+
+// validate menjalankan tag untuk value dengan nama name, namespace error diubah agar diawali struct paling luar
+func (j parallelJob) validate(name, goName string, value any, tag string) validator.ValidationErrors {
+	err := j.v.Validate.VarWithKeyCtx(j.ctx, name, value, tag)
+	validationErrors, _ := err.(validator.ValidationErrors)
+	for i, fieldError := range validationErrors {
+		validationErrors[i] = j.prefix(fieldError, name, name, goName)
+	}
+
+	return validationErrors
+}
+
+// prefix mengganti awal namespace error (from) dengan lokasi element di struct paling luar
+func (j parallelJob) prefix(fieldError validator.FieldError, from, name, goName string) validator.FieldError {
+	return &prefixedError{
+		FieldError:      fieldError,
+		namespace:       j.namespace + "." + name + strings.TrimPrefix(fieldError.Namespace(), from),
+		structNamespace: j.namespace + "." + goName + strings.TrimPrefix(fieldError.StructNamespace(), from),
+	}
+}
+
+// keyString mengubah key map menjadi string untuk namespace dan urutan, sama dengan format namespace validator
+func keyString(key reflect.Value) string {
+	return fmt.Sprintf("%v", key)
+}
+
+// prefixedError adalah error element yang divalidasi terpisah, namespace nya diawali struct paling luar
+type prefixedError struct {
+	validator.FieldError
+	namespace       string
+	structNamespace string
+}
+
+func (e *prefixedError) Namespace() string {
+	return e.namespace
+}
+
+func (e *prefixedError) StructNamespace() string {
+	return e.structNamespace
+}
+
+func (e *prefixedError) Error() string {
+	return fmt.Sprintf("Key: '%s' Error:Field validation for '%s' failed on the '%s' tag", e.namespace, e.Field(), e.Tag())
+}
@@ -0,0 +1,164 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+
+	"belajar-go-lang-validation/model"
+)
+
+func bulkUser() model.User {
+	user := model.User{Id: "1", Hobbies: []string{"Coding"}, Schools: map[string]model.School{}, Wallets: map[string]int{}}
+	for i := range 500 {
+		address := model.Address{City: "Malang", Country: "Indonesia"}
+		if i%7 == 0 {
+			address.City = ""
+		}
+		user.Address = append(user.Address, address)
+		user.Hobbies = append(user.Hobbies, fmt.Sprint("TV"[:i%3]))
+		user.Schools[fmt.Sprintf("S%03d", i)] = model.School{Name: "SMP"[:i%4]}
+	}
+	user.Schools["S"] = model.School{Name: "SD"}
+
+	return user
+}
+
+func errorPaths(fieldErrors []FieldError) []string {
+	var paths []string
+	for _, fieldError := range fieldErrors {
+		paths = append(paths, fieldError.Path+" "+fieldError.Tag+" "+fieldError.Pointer)
+	}
+
+	return paths
+}
+
+func TestStructParallel(t *testing.T) {
+	validate := Default()
+	user := bulkUser()
+
+	expected := errorPaths(validate.FieldErrors(validate.Struct(user)))
+	sort.Strings(expected)
+
+	var first []string
+	for range 3 {
+		err := validate.StructParallel(context.Background(), user, ParallelOptions{Workers: 4, MinItems: 100})
+		var validationErrors validator.ValidationErrors
+		if !errors.As(err, &validationErrors) {
+			t.Fatalf("expected validation errors, got %v", err)
+		}
+
+		paths := errorPaths(validate.FieldErrors(err))
+		if first == nil {
+			first = paths
+		} else if !reflect.DeepEqual(first, paths) {
+			t.Fatal("expected deterministic error order")
+		}
+	}
+
+	// urutan field dan index tetap, isinya sama dengan validasi biasa
+	if first[0] != "name required /name" || first[1] != "address[0].city required /address/0/city" {
+		t.Errorf("unexpected order %v", first[:2])
+	}
+	sorted := append([]string(nil), first...)
+	sort.Strings(sorted)
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("expected same errors as Struct\n got: %v\nwant: %v", sorted, expected)
+	}
+
+	// collection kecil divalidasi biasa
+	small := model.User{Id: "1", Name: "Taufik", Address: []model.Address{{City: "Malang"}}, Hobbies: []string{"Coding"}}
+	fieldErrors := validate.FieldErrors(validate.StructParallel(context.Background(), small, ParallelOptions{}))
+	if len(fieldErrors) != 1 || fieldErrors[0].Path != "address[0].country" {
+		t.Errorf("unexpected errors %+v", fieldErrors)
+	}
+}
+
+func TestStructParallelWorkers(t *testing.T) {
+	type batch struct {
+		Items []int `json:"items" validate:"required,min=2,dive,probe"`
+	}
+
+	// validator sendiri agar tag probe tidak terdaftar di Default yang dipakai test lain
+	validate, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var running, peak atomic.Int64
+	if err := validate.RegisterValidation("probe", func(field validator.FieldLevel) bool {
+		current := running.Add(1)
+		for {
+			max := peak.Load()
+			if current <= max || peak.CompareAndSwap(max, current) {
+				break
+			}
+		}
+		time.Sleep(time.Microsecond)
+		running.Add(-1)
+		return field.Field().Int()%100 != 0
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	items := make([]int, 1000)
+	for i := range items {
+		items[i] = i + 1
+	}
+
+	err = validate.StructParallel(context.Background(), batch{Items: items}, ParallelOptions{Workers: 2, MinItems: 10})
+	fieldErrors := validate.FieldErrors(err)
+	if len(fieldErrors) != 10 || fieldErrors[0].Path != "items[99]" || fieldErrors[9].Path != "items[999]" {
+		t.Errorf("unexpected errors %+v", fieldErrors)
+	}
+	if peak.Load() > 2 {
+		t.Errorf("expected at most 2 workers, got %d", peak.Load())
+	}
+
+	// tag sebelum dive tetap divalidasi
+	err = validate.StructParallel(context.Background(), batch{Items: []int{100}}, ParallelOptions{MinItems: 1})
+	if fieldErrors := validate.FieldErrors(err); len(fieldErrors) != 1 || fieldErrors[0].Tag != "min" {
+		t.Errorf("unexpected errors %+v", fieldErrors)
+	}
+}
+
+func TestStructParallelCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := Default().StructParallel(ctx, bulkUser(), ParallelOptions{MinItems: 100})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context canceled, got %v", err)
+	}
+}
+
+func TestStructParallelMapKeys(t *testing.T) {
+	validate := Default()
+	user := model.User{
+		Id:      "1",
+		Name:    "Taufik",
+		Address: []model.Address{{City: "Malang", Country: "Indonesia"}},
+		Hobbies: []string{"Coding"},
+		Schools: map[string]model.School{"SD": {Name: "SD 1"}, "S": {}},
+		Wallets: map[string]int{"BCA": 5000, "XYZ": 10},
+	}
+
+	// key yang tidak valid tidak menghentikan validasi value nya, sama seperti Struct
+	expected := errorPaths(validate.FieldErrors(validate.Struct(user)))
+	sort.Strings(expected)
+	if len(expected) != 4 {
+		t.Fatalf("expected 4 errors from Struct, got %v", expected)
+	}
+
+	paths := errorPaths(validate.FieldErrors(validate.StructParallel(context.Background(), user, ParallelOptions{Workers: 2, MinItems: 1})))
+	sort.Strings(paths)
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected same errors as Struct\n got: %v\nwant: %v", paths, expected)
+	}
+}
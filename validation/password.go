@@ -0,0 +1,274 @@
+package validation
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TagPassword adalah tag untuk validasi password dengan parameter nama policy, contoh password=strong
+const TagPassword = "password"
+
+// tag untuk setiap sub-rule password, setiap sub-rule yang gagal akan menjadi satu error tersendiri
+const (
+	TagPasswordLength = "password_length"
+	TagPasswordMax    = "password_max"
+	TagPasswordUpper  = "password_upper"
+	TagPasswordLower  = "password_lower"
+	TagPasswordDigit  = "password_digit"
+	TagPasswordSymbol = "password_symbol"
+	TagPasswordRepeat = "password_repeat"
+	TagPasswordBanned = "password_banned"
+	TagPasswordCommon = "password_common"
+)
+
+//go:embed data/common-passwords.txt
+var commonPasswordsTXT []byte
+
+var commonPasswords = loadCommonPasswords(commonPasswordsTXT)
+
+func loadCommonPasswords(data []byte) map[string]bool {
+	result := map[string]bool{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		text := strings.TrimSpace(scanner.Text())
+		if text != "" && !strings.HasPrefix(text, "#") {
+			result[strings.ToLower(text)] = true
+		}
+	}
+
+	return result
+}
+
+// PasswordPolicy adalah aturan password, nilai 0 atau false berarti aturan tersebut tidak dicek
+type PasswordPolicy struct {
+	MinLength     int
+	MaxLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+	// MaxRepeat adalah jumlah maksimal karakter yang sama berturut turut, contoh 2 menolak "aaa"
+	MaxRepeat int
+	// BannedFields adalah nama field struct (satu level dengan password) yang isinya tidak boleh ada di password
+	// contoh Username atau Email, untuk email bagian sebelum @ juga ikut dicek
+	BannedFields []string
+	// RejectCommon menolak password yang ada di daftar password umum yang di embed di package ini
+	RejectCommon bool
+}
+
+// policy bawaan, basic sama dengan aturan lama min=5
+var passwordPolicies = map[string]PasswordPolicy{
+	"basic": {
+		MinLength: 5,
+	},
+	"strong": {
+		MinLength:     8,
+		MaxLength:     72,
+		RequireUpper:  true,
+		RequireLower:  true,
+		RequireDigit:  true,
+		RequireSymbol: true,
+		MaxRepeat:     2,
+		BannedFields:  []string{"Username", "Email"},
+		RejectCommon:  true,
+	},
+}
+
+// WithPasswordPolicy menambahkan policy baru atau mengganti policy bawaan dengan nama yang sama
+func WithPasswordPolicy(name string, policy PasswordPolicy) Option {
+	return func(o *options) {
+		o.passwordPolicies[name] = policy
+	}
+}
+
+// Check mengecek password dan mengembalikan seluruh sub-rule yang gagal
+// banned berisi isi field yang tidak boleh ada di password, dengan key nama field
+func (p PasswordPolicy) Check(password string, banned map[string]string) []RuleViolation {
+	return p.check(password, banned, nil)
+}
+
+// check sama dengan Check, names adalah nama field di pesan error untuk param password_banned, contoh Username
+// menjadi username sesuai tag json. Field yang tidak ada di names memakai nama field struct
+func (p PasswordPolicy) check(password string, banned, names map[string]string) []RuleViolation {
+	var violations []RuleViolation
+
+	length := len([]rune(password))
+	if length < p.MinLength {
+		violations = append(violations, RuleViolation{Tag: TagPasswordLength, Param: strconv.Itoa(p.MinLength)})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, RuleViolation{Tag: TagPasswordMax, Param: strconv.Itoa(p.MaxLength)})
+	}
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	classes := []struct {
+		required, found bool
+		tag             string
+	}{
+		{p.RequireUpper, upper, TagPasswordUpper},
+		{p.RequireLower, lower, TagPasswordLower},
+		{p.RequireDigit, digit, TagPasswordDigit},
+		{p.RequireSymbol, symbol, TagPasswordSymbol},
+	}
+	for _, class := range classes {
+		if class.required && !class.found {
+			violations = append(violations, RuleViolation{Tag: class.tag})
+		}
+	}
+
+	if p.MaxRepeat > 0 && maxRepeat(password) > p.MaxRepeat {
+		violations = append(violations, RuleViolation{Tag: TagPasswordRepeat, Param: strconv.Itoa(p.MaxRepeat)})
+	}
+
+	lowerPassword := strings.ToLower(password)
+	for _, name := range p.BannedFields {
+		if containsBanned(lowerPassword, banned[name]) {
+			param := name
+			if displayName, ok := names[name]; ok {
+				param = displayName
+			}
+			violations = append(violations, RuleViolation{Tag: TagPasswordBanned, Param: param})
+		}
+	}
+
+	if p.RejectCommon && commonPasswords[lowerPassword] {
+		violations = append(violations, RuleViolation{Tag: TagPasswordCommon})
+	}
+
+	return violations
+}
+
+// maxRepeat menghitung jumlah karakter sama berturut turut yang paling panjang
+func maxRepeat(value string) int {
+	longest, current := 0, 0
+	var previous rune
+	for i, r := range []rune(value) {
+		if i > 0 && r == previous {
+			current++
+		} else {
+			current = 1
+		}
+		previous = r
+		longest = max(longest, current)
+	}
+
+	return longest
+}
+
+// containsBanned mengecek isi field terlarang ada di dalam password, isi kurang dari 3 karakter diabaikan
+func containsBanned(lowerPassword, value string) bool {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	candidates := []string{value}
+	if local, _, ok := strings.Cut(value, "@"); ok {
+		candidates = append(candidates, local)
+	}
+
+	for _, candidate := range candidates {
+		if len(candidate) >= 3 && strings.Contains(lowerPassword, candidate) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bannedValues mengambil isi field terlarang dari struct parent, field yang bukan string akan diabaikan
+func bannedValues(parent reflect.Value, names []string) map[string]string {
+	for parent.Kind() == reflect.Pointer || parent.Kind() == reflect.Interface {
+		if parent.IsNil() {
+			return nil
+		}
+		parent = parent.Elem()
+	}
+	if parent.Kind() != reflect.Struct {
+		return nil
+	}
+
+	values := map[string]string{}
+	for _, name := range names {
+		if field := parent.FieldByName(name); field.IsValid() && field.Kind() == reflect.String {
+			values[name] = field.String()
+		}
+	}
+
+	return values
+}
+
+// bannedNames mengambil nama field terlarang sesuai TagNameFunc (tag json, form atau query), sama dengan nama field
+// di namespace error, contoh Username menjadi username
+func bannedNames(parent reflect.Value, names []string) map[string]string {
+	parent = indirect(parent)
+	if parent.Kind() != reflect.Struct {
+		return nil
+	}
+
+	displayNames := map[string]string{}
+	for _, name := range names {
+		if field, ok := parent.Type().FieldByName(name); ok {
+			displayNames[name] = FieldName(field)
+		}
+	}
+
+	return displayNames
+}
+
+// validPassword membuat fungsi validasi password dengan daftar policy tertentu
+func validPassword(policies map[string]PasswordPolicy) validator.Func {
+	return func(field validator.FieldLevel) bool {
+		value, ok := field.Field().Interface().(string)
+		if !ok {
+			return false
+		}
+
+		policy, ok := policies[field.Param()]
+		if !ok {
+			return false
+		}
+
+		return len(policy.Check(value, bannedValues(field.Parent(), policy.BannedFields))) == 0
+	}
+}
+
+// passwordErrors memecah error tag password menjadi satu error untuk setiap sub-rule yang gagal
+// parent adalah struct yang memiliki field error, jika tidak valid aturan BannedFields tidak dicek
+func (v *Validator) passwordErrors(fieldError validator.FieldError, parent reflect.Value) []validator.FieldError {
+	policy, ok := v.passwordPolicies[fieldError.Param()]
+	value, isString := fieldError.Value().(string)
+	if !ok || !isString {
+		return []validator.FieldError{fieldError}
+	}
+
+	violations := policy.check(value, bannedValues(parent, policy.BannedFields), bannedNames(parent, policy.BannedFields))
+	if len(violations) == 0 {
+		return []validator.FieldError{fieldError}
+	}
+
+	result := make([]validator.FieldError, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, &ruleError{FieldError: fieldError, tag: violation.Tag, param: violation.Param})
+	}
+
+	return result
+}
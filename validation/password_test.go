@@ -0,0 +1,74 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+)
+
+func tags(err error) []string {
+	var result []string
+	for _, fieldError := range Default().FieldErrors(err) {
+		result = append(result, fieldError.Tag)
+	}
+
+	return result
+}
+
+func TestPasswordPolicyStrong(t *testing.T) {
+	request := model.RegisterUser{
+		Username:        "taufik@gmail.com",
+		Password:        "taufikkk",
+		ConfirmPassword: "taufikkk",
+	}
+
+	got := tags(Default().Struct(request))
+	expected := []string{TagPasswordUpper, TagPasswordDigit, TagPasswordSymbol, TagPasswordRepeat, TagPasswordBanned}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	}
+
+	// nama field terlarang di pesan sama dengan nama field di request, bukan nama field struct
+	fieldErrors := Default().FieldErrors(Default().Struct(request), LocaleEN)
+	if banned := fieldErrors[len(fieldErrors)-1]; banned.Param != "username" || banned.Message != "password must not contain the username" {
+		t.Errorf("unexpected banned error %+v", banned)
+	}
+
+	request.Password, request.ConfirmPassword = "Rahasia#Kuat9", "Rahasia#Kuat9"
+	if err := Default().Struct(request); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestPasswordPolicyCustom(t *testing.T) {
+	validate, err := New(WithPasswordPolicy("pin", PasswordPolicy{MinLength: 6, MaxLength: 6, RequireDigit: true, RejectCommon: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type Account struct {
+		Pin string `json:"pin" validate:"password=pin"`
+	}
+
+	fieldErrors := validate.FieldErrors(validate.Struct(Account{Pin: "123456"}), LocaleID)
+	if len(fieldErrors) != 1 || fieldErrors[0].Tag != TagPasswordCommon {
+		t.Fatalf("unexpected errors %+v", fieldErrors)
+	}
+	if fieldErrors[0].Message != "pin terlalu umum dan mudah ditebak" {
+		t.Errorf("unexpected message %q", fieldErrors[0].Message)
+	}
+
+	if err := validate.Var("7654321", "password=pin"); len(tags(err)) != 1 || tags(err)[0] != TagPasswordMax {
+		t.Errorf("unexpected errors %v", err)
+	}
+	var configError *ConfigError
+	if err := validate.Var("Rahasia#Kuat9", "password=unknown"); !errors.As(err, &configError) {
+		t.Errorf("unknown policy must return config error, got %v", err)
+	}
+}
@@ -0,0 +1,273 @@
+package validation
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Presence adalah daftar lokasi field yang dikirim client dalam bentuk JSON pointer, contoh /address/0/city
+// setiap parent juga tercatat, contoh /address dan /address/0
+type Presence map[string]bool
+
+// Has mengecek field di pointer dikirim oleh client
+func (p Presence) Has(pointer string) bool {
+	return p[pointer]
+}
+
+// DecodePartial melakukan decode JSON ke target sekaligus mencatat field yang dikirim client, digunakan untuk request PATCH
+// nama key di JSON disamakan dengan nama field di error (encoding/json tidak membedakan huruf besar kecil), sehingga
+// {"Username": ...} tercatat sebagai /username jika tag json field tersebut username
+func DecodePartial(data []byte, target any) (Presence, error) {
+	if err := json.Unmarshal(data, target); err != nil {
+		return nil, err
+	}
+
+	var document any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+
+	presence := Presence{}
+	presence.collect("", document, reflect.TypeOf(target))
+
+	return presence, nil
+}
+
+// collect mencatat lokasi value dan seluruh isinya, typ adalah tipe go tujuan decode (nil jika tidak diketahui)
+func (p Presence) collect(pointer string, value any, typ reflect.Type) {
+	if pointer != "" {
+		p[pointer] = true
+	}
+	if typ != nil {
+		typ = indirectType(typ)
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		for key, element := range value {
+			name, elementType := key, reflect.Type(nil)
+			switch {
+			case typ == nil:
+			case typ.Kind() == reflect.Struct:
+				if field, ok := jsonField(typ, key); ok {
+					name, elementType = FieldName(field), field.Type
+				}
+			case typ.Kind() == reflect.Map:
+				elementType = typ.Elem()
+			}
+			p.collect(pointer+"/"+escapePointer(name), element, elementType)
+		}
+	case []any:
+		var elementType reflect.Type
+		if typ != nil && (typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array) {
+			elementType = typ.Elem()
+		}
+		for i, element := range value {
+			p.collect(pointer+"/"+strconv.Itoa(i), element, elementType)
+		}
+	}
+}
+
+// jsonField mencari field tujuan key JSON dengan aturan yang sama dengan encoding/json, nama yang sama persis didahulukan
+func jsonField(typ reflect.Type, key string) (reflect.StructField, bool) {
+	var folded *reflect.StructField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() || field.Tag.Get("json") == "-" {
+			continue
+		}
+
+		name := field.Name
+		if tag, _, _ := strings.Cut(field.Tag.Get("json"), ","); tag != "" {
+			name = tag
+		}
+		if name == key {
+			return field, true
+		}
+		if folded == nil && strings.EqualFold(name, key) {
+			folded = &field
+		}
+	}
+
+	if folded == nil {
+		return reflect.StructField{}, false
+	}
+	return *folded, true
+}
+
+// Patch memvalidasi struct hasil DecodePartial, hanya error untuk field yang dikirim client yang dikembalikan
+// termasuk field di dalam element slice dan map. Tag yang membandingkan dengan field lain (contoh eqfield=Password)
+// hanya dikembalikan jika field pembandingnya juga dikirim. Error struct level (contoh register_username) hanya
+// dikembalikan jika seluruh field struct tersebut dikirim client
+func (v *Validator) Patch(s any, presence Presence) error {
+	return v.PatchCtx(context.Background(), s, presence)
+}
+
+// PatchCtx sama dengan Patch dengan context
+// field yang tidak dikirim tidak divalidasi, sehingga tag available tidak melakukan pengecekan ke repository untuk field
+// tersebut. Batas dari WithErrorLimit diterapkan setelah error field yang tidak dikirim dibuang
+func (v *Validator) PatchCtx(ctx context.Context, s any, presence Presence) error {
+	var absent []string
+	absentFields(reflect.ValueOf(s), "", "", presence, &absent)
+	err := v.structCtx(WithErrorLimit(ctx, ErrorLimit{}), s, absent...)
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return err
+	}
+
+	root := reflect.ValueOf(s)
+	var present validator.ValidationErrors
+	for _, fieldError := range validationErrors {
+		pointer := fieldPointer(fieldError)
+		if !presence.Has(pointer) || !v.referencesPresent(fieldError, root, pointer, presence) || !v.structLevelPresent(fieldError, root, presence) {
+			continue
+		}
+		present = append(present, fieldError)
+	}
+
+	if len(present) == 0 {
+		return nil
+	}
+
+	return errorLimitFrom(ctx).apply(present)
+}
+
+// absentFields mengumpulkan namespace field go (tanpa nama struct paling luar) yang tidak dikirim client, contoh
+// Address[0].City, digunakan sebagai parameter StructExceptCtx
+func absentFields(value reflect.Value, namespace, pointer string, presence Presence, absent *[]string) {
+	value = indirect(value)
+	switch value.Kind() {
+	case reflect.Struct:
+		typ := value.Type()
+		if isTextUnmarshaler(typ) {
+			return
+		}
+		for i := 0; i < typ.NumField(); i++ {
+			field := typ.Field(i)
+			if !field.IsExported() {
+				continue
+			}
+
+			fieldNamespace, fieldPointer := field.Name, pointer+"/"+escapePointer(FieldName(field))
+			if namespace != "" {
+				fieldNamespace = namespace + "." + field.Name
+			}
+			if !presence.Has(fieldPointer) {
+				*absent = append(*absent, fieldNamespace)
+				continue
+			}
+			absentFields(value.Field(i), fieldNamespace, fieldPointer, presence, absent)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			absentFields(value.Index(i), namespace+"["+strconv.Itoa(i)+"]", pointer+"/"+strconv.Itoa(i), presence, absent)
+		}
+	case reflect.Map:
+		for _, key := range value.MapKeys() {
+			name := keyString(key)
+			absentFields(value.MapIndex(key), namespace+"["+name+"]", pointer+"/"+escapePointer(name), presence, absent)
+		}
+	}
+}
+
+// structLevelPresent mengecek error dari struct level validation (tag error tidak ada di tag field tersebut) hanya
+// dikembalikan jika seluruh field struct tersebut dikirim oleh client. field yang dibaca struct level validation tidak
+// diketahui, contoh MustValidRegisterSuccess membandingkan username dengan email dan phone yang tidak dikirim
+func (v *Validator) structLevelPresent(fieldError validator.FieldError, root reflect.Value, presence Presence) bool {
+	location, ok := locate(root, fieldError.StructNamespace())
+	if !ok || v.fieldHasTag(location.parent.Type(), location.field, originalTag(fieldError)) {
+		return true
+	}
+
+	typ := location.parent.Type()
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.IsExported() && !presence.Has(location.parentPointer+"/"+escapePointer(FieldName(field))) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldHasTag mengecek tag validate field (atau rule dari rule file) berisi tag, contoh min pada required,min=3
+func (v *Validator) fieldHasTag(typ reflect.Type, field reflect.StructField, tag string) bool {
+	fieldTag, ok := v.fieldRules[typ][field.Name]
+	if !ok {
+		fieldTag = field.Tag.Get("validate")
+	}
+
+	for _, token := range splitTag(fieldTag) {
+		if name, _, _ := strings.Cut(token, "="); name == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// originalTag mengambil tag error sebelum dipecah per sub-rule, contoh password untuk error password_upper
+func originalTag(fieldError validator.FieldError) string {
+	for {
+		switch e := fieldError.(type) {
+		case *locatedError:
+			fieldError = e.FieldError
+		case *ruleError:
+			fieldError = e.FieldError
+		default:
+			return fieldError.Tag()
+		}
+	}
+}
+
+// referencesPresent mengecek field yang direferensikan tag (contoh Password di eqfield=Password) dikirim oleh client
+// field referensi berada di struct yang sama dengan field error, sehingga pointer nya adalah sibling
+func (v *Validator) referencesPresent(fieldError validator.FieldError, root reflect.Value, pointer string, presence Presence) bool {
+	references, ok := fieldReferenceTags[fieldError.ActualTag()]
+	if !ok {
+		return true
+	}
+
+	location, _ := locate(root, fieldError.StructNamespace())
+	parent := indirect(location.parent)
+	if parent.Kind() != reflect.Struct {
+		return true
+	}
+
+	parentPointer := pointer[:strings.LastIndexByte(pointer, '/')]
+	for _, path := range references(fieldError.Param()) {
+		referencePointer, ok := fieldPathPointer(parent.Type(), path)
+		if ok && !presence.Has(parentPointer+referencePointer) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// fieldPathPointer mengubah path field go menjadi JSON pointer, contoh Address.City menjadi /address/city
+func fieldPathPointer(typ reflect.Type, path string) (string, bool) {
+	var pointer strings.Builder
+	for _, name := range strings.Split(path, ".") {
+		typ = indirectType(typ)
+		if typ.Kind() != reflect.Struct {
+			return "", false
+		}
+
+		field, ok := typ.FieldByName(name)
+		if !ok {
+			return "", false
+		}
+		pointer.WriteString("/" + escapePointer(FieldName(field)))
+		typ = field.Type
+	}
+
+	return pointer.String(), true
+}
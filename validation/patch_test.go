@@ -0,0 +1,94 @@
+package validation
+
+import (
+	"testing"
+
+	"belajar-go-lang-validation/model"
+)
+
+func patchErrors(t *testing.T, body string, target any) []FieldError {
+	t.Helper()
+
+	presence, err := DecodePartial([]byte(body), target)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	validate := Default()
+	return validate.FieldErrors(validate.Patch(target, presence))
+}
+
+func TestPatchNested(t *testing.T) {
+	var user model.User
+	fieldErrors := patchErrors(t, `{
+		"name": "",
+		"address": [{"city": "Malang"}, {"city": "", "country": "Indonesia"}],
+		"schools": {"S": {"name": ""}, "SMP": {}},
+		"hobbies": ["Coding", "TV"]
+	}`, &user)
+
+	// id, address[0].country dan schools["SMP"].name tidak dikirim sehingga tidak divalidasi
+	expected := []string{"/name", "/address/1/city", "/hobbies/1", "/schools/S", "/schools/S/name"}
+	found := map[string]bool{}
+	for _, fieldError := range fieldErrors {
+		found[fieldError.Pointer] = true
+	}
+	if len(fieldErrors) != len(expected) {
+		t.Errorf("expected %d errors, got %+v", len(expected), fieldErrors)
+	}
+	for _, pointer := range expected {
+		if !found[pointer] {
+			t.Errorf("missing error for %s in %+v", pointer, fieldErrors)
+		}
+	}
+}
+
+func TestPatchCrossField(t *testing.T) {
+	// password tidak dikirim, eqfield=Password tidak bisa dibandingkan
+	if fieldErrors := patchErrors(t, `{"confirm_password": "rahasia"}`, &model.RegisterUser{}); len(fieldErrors) != 0 {
+		t.Errorf("expected no errors, got %+v", fieldErrors)
+	}
+
+	// key JSON tidak membedakan huruf besar kecil, sama dengan encoding/json
+	fieldErrors := patchErrors(t, `{"Password": "Rahasia#Kuat9", "CONFIRM_PASSWORD": "rahasia"}`, &model.RegisterUser{})
+	if len(fieldErrors) != 1 || fieldErrors[0].Pointer != "/confirm_password" || fieldErrors[0].Tag != "eqfield" {
+		t.Errorf("expected eqfield error, got %+v", fieldErrors)
+	}
+
+	if fieldErrors := patchErrors(t, `{"password": "Rahasia#Kuat9", "confirm_password": "Rahasia#Kuat9"}`, &model.RegisterUser{}); len(fieldErrors) != 0 {
+		t.Errorf("expected no errors, got %+v", fieldErrors)
+	}
+}
+
+func TestDecodePartialPresence(t *testing.T) {
+	var user model.User
+	presence, err := DecodePartial([]byte(`{"Name": null, "address": [{"city": "Malang"}], "wallets": {"BCA": 1}, "unknown": {"a": 1}}`), &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, pointer := range []string{"/name", "/address", "/address/0", "/address/0/city", "/wallets/BCA", "/unknown/a"} {
+		if !presence.Has(pointer) {
+			t.Errorf("expected %s to be present in %v", pointer, presence)
+		}
+	}
+	if presence.Has("/id") || presence.Has("/address/0/country") {
+		t.Errorf("unexpected presence %v", presence)
+	}
+
+	if _, err := DecodePartial([]byte(`{"name": 1}`), &user); err == nil {
+		t.Error("expected decode error")
+	}
+}
+
+func TestPatchStructLevel(t *testing.T) {
+	// email dan phone tidak dikirim, MustValidRegisterSuccess tidak bisa membandingkan username
+	if fieldErrors := patchErrors(t, `{"username": "zzz"}`, &model.RegisterRequest{}); len(fieldErrors) != 0 {
+		t.Errorf("expected no errors, got %+v", fieldErrors)
+	}
+
+	fieldErrors := patchErrors(t, `{"username": "zzz", "email": "taufik@email.com", "phone": "081234567890", "password": "rahasia"}`, &model.RegisterRequest{})
+	if len(fieldErrors) != 1 || fieldErrors[0].Tag != TagRegisterUsername {
+		t.Errorf("expected register_username error, got %+v", fieldErrors)
+	}
+}
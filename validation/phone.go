@@ -0,0 +1,119 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// TagPhoneID adalah tag untuk validasi nomor handphone indonesia
+const TagPhoneID = "phone_id"
+
+// error yang dikembalikan NormalizePhone, bisa dicek menggunakan errors.Is
+var (
+	ErrPhoneFormat   = errors.New("phone must start with 08, 628 or +628 followed by digits")
+	ErrPhoneOperator = errors.New("phone operator prefix is unknown")
+	ErrPhoneLength   = errors.New("phone length is out of range")
+)
+
+// prefix operator seluler indonesia (tanpa angka 0 di depan) beserta nama operatornya
+var phoneOperators = map[string]string{
+	"811": "Telkomsel", "812": "Telkomsel", "813": "Telkomsel",
+	"821": "Telkomsel", "822": "Telkomsel", "823": "Telkomsel",
+	"851": "Telkomsel", "852": "Telkomsel", "853": "Telkomsel",
+	"814": "Indosat", "815": "Indosat", "816": "Indosat",
+	"855": "Indosat", "856": "Indosat", "857": "Indosat", "858": "Indosat",
+	"817": "XL", "818": "XL", "819": "XL",
+	"859": "XL", "877": "XL", "878": "XL",
+	"831": "Axis", "832": "Axis", "833": "Axis", "838": "Axis",
+	"895": "Tri", "896": "Tri", "897": "Tri", "898": "Tri", "899": "Tri",
+	"881": "Smartfren", "882": "Smartfren", "883": "Smartfren", "884": "Smartfren",
+	"885": "Smartfren", "886": "Smartfren", "887": "Smartfren", "888": "Smartfren", "889": "Smartfren",
+}
+
+// panjang nomor nasional tanpa angka 0 di depan, contoh 81234567890 (11 digit)
+const (
+	phoneMinLength = 9
+	phoneMaxLength = 12
+)
+
+// NormalizePhone mengubah nomor handphone 08xx, 628xx atau +628xx menjadi format E.164, contoh +6281234567890
+// spasi, tanda minus, titik dan tanda kurung akan dihapus terlebih dahulu
+func NormalizePhone(value string) (string, error) {
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, value)
+
+	var national string
+	switch {
+	case strings.HasPrefix(digits, "+628"):
+		national = digits[3:]
+	case strings.HasPrefix(digits, "628"):
+		national = digits[2:]
+	case strings.HasPrefix(digits, "08"):
+		national = digits[1:]
+	default:
+		return "", ErrPhoneFormat
+	}
+
+	if !regexNumber.MatchString(national) {
+		return "", ErrPhoneFormat
+	}
+	if len(national) < phoneMinLength || len(national) > phoneMaxLength {
+		return "", ErrPhoneLength
+	}
+	if _, ok := phoneOperators[national[:3]]; !ok {
+		return "", ErrPhoneOperator
+	}
+
+	return "+62" + national, nil
+}
+
+// RewritePhone mengganti isi phone menjadi format E.164, isi phone tidak diubah jika bukan nomor yang valid
+func RewritePhone(phone *string) error {
+	normalized, err := NormalizePhone(*phone)
+	if err != nil {
+		return err
+	}
+
+	*phone = normalized
+	return nil
+}
+
+// PhoneOperator mengembalikan nama operator dari nomor handphone
+func PhoneOperator(value string) (string, bool) {
+	phone, err := NormalizePhone(value)
+	if err != nil {
+		return "", false
+	}
+
+	operator, ok := phoneOperators[phone[3:6]]
+	return operator, ok
+}
+
+// samePhone mengecek apakah dua value adalah nomor handphone yang sama walaupun formatnya berbeda
+func samePhone(first, second string) bool {
+	firstPhone, err := NormalizePhone(first)
+	if err != nil {
+		return false
+	}
+
+	secondPhone, err := NormalizePhone(second)
+	return err == nil && firstPhone == secondPhone
+}
+
+// MustValidPhoneID memastikan value adalah nomor handphone indonesia yang valid
+func MustValidPhoneID(field validator.FieldLevel) bool {
+	value, ok := field.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+
+	_, err := NormalizePhone(value)
+	return err == nil
+}
@@ -0,0 +1,62 @@
+package validation
+
+import (
+	"errors"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+)
+
+func TestNormalizePhone(t *testing.T) {
+	for _, value := range []string{"081234567890", "6281234567890", "+62 812-3456-7890", "(0812) 3456.7890"} {
+		phone, err := NormalizePhone(value)
+		if err != nil || phone != "+6281234567890" {
+			t.Errorf("%s: unexpected %s (%v)", value, phone, err)
+		}
+	}
+
+	cases := map[string]error{
+		"0000":            ErrPhoneFormat,
+		"+6521234567890":  ErrPhoneFormat,
+		"0812345":         ErrPhoneLength,
+		"08123456789012":  ErrPhoneLength,
+		"081a34567890":    ErrPhoneFormat,
+		"0800123456789":   ErrPhoneOperator,
+		"+62 21 5551234":  ErrPhoneFormat,
+		"08991234567":     nil,
+		"+62 877 1234567": nil,
+	}
+	for value, expected := range cases {
+		if _, err := NormalizePhone(value); !errors.Is(err, expected) {
+			t.Errorf("%s: expected %v, got %v", value, expected, err)
+		}
+	}
+
+	phone := "0812 3456 7890"
+	if err := RewritePhone(&phone); err != nil || phone != "+6281234567890" {
+		t.Errorf("unexpected rewrite %s (%v)", phone, err)
+	}
+	if operator, _ := PhoneOperator(phone); operator != "Telkomsel" {
+		t.Errorf("unexpected operator %s", operator)
+	}
+}
+
+func TestPhoneComparison(t *testing.T) {
+	type User struct {
+		Username string `validate:"required,field_equals_ignore_case=Email|field_equals_ignore_case=Phone"`
+		Email    string `validate:"required,email"`
+		Phone    string `validate:"required,phone_id"`
+	}
+
+	if err := Default().Struct(User{Username: "+6281234567890", Email: "taufik@email.com", Phone: "081234567890"}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := Default().Struct(User{Username: "081234567890", Email: "taufik@email.com", Phone: "0000"}); err == nil {
+		t.Error("expected phone 0000 to fail")
+	}
+
+	request := model.RegisterRequest{Username: "62 812 3456 7890", Email: "taufik@email.com", Phone: "081234567890", Password: "rahasia"}
+	if err := Default().Struct(request); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
@@ -0,0 +1,278 @@
+package validation
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+
+	"belajar-go-lang-validation/model"
+
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v3"
+)
+
+// RuleFile adalah rule validasi yang ditulis di file YAML atau JSON, bukan di struct tag
+//
+//	aliases:
+//	  short_name: required,min=3,max=50
+//	types:
+//	  RegisterRequest:
+//	    fields:
+//	      username: required,short_name
+//	      email: required,email
+//	    struct: [register_username]
+//
+// key fields adalah nama field di json (atau nama field go), isinya sama dengan isi tag validate
+// tipe yang bukan struct go (tidak ada di model dan tidak diberikan ke WithRules) hanya bisa dipakai dengan Map
+type RuleFile struct {
+	Aliases map[string]string    `json:"aliases" yaml:"aliases"`
+	Types   map[string]TypeRules `json:"types" yaml:"types"`
+}
+
+// TypeRules adalah rule untuk satu tipe
+type TypeRules struct {
+	// Fields adalah tag validate per field, menggantikan tag validate di struct untuk field tersebut
+	Fields map[string]string `json:"fields" yaml:"fields"`
+	// Struct adalah nama struct level validation, menggantikan struct level validation bawaan tipe tersebut
+	Struct []string `json:"struct" yaml:"struct"`
+}
+
+// ParseRules membaca rule file dalam format YAML atau JSON (JSON adalah bagian dari YAML)
+// key yang tidak dikenal dianggap error, sehingga salah ketik seperti field: tidak diabaikan begitu saja
+func ParseRules(data []byte) (*RuleFile, error) {
+	rules := &RuleFile{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(rules); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("validation: invalid rule file: %w", err)
+	}
+
+	return rules, nil
+}
+
+// LoadRules membaca rule file dari disk, isi rule dicek saat diberikan ke New menggunakan WithRules
+func LoadRules(path string) (*RuleFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := ParseRules(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return rules, nil
+}
+
+// WithRules menerapkan rule file ke Validator, types adalah struct tambahan selain tipe yang ada di model
+// seluruh isi rule file dicek saat New dipanggil, jika ada yang salah New mengembalikan *ConfigError
+func WithRules(rules *RuleFile, types ...any) Option {
+	return func(o *options) {
+		o.rules = rules
+		o.ruleTypes = append(o.ruleTypes, types...)
+	}
+}
+
+// structRule adalah struct level validation yang bisa dipakai dari rule file, hanya untuk tipe typ
+type structRule struct {
+	fn  validator.StructLevelFunc
+	typ reflect.Type
+}
+
+// struct level validation bawaan project yang bisa dipakai dari rule file, key adalah nama di rule file
+var structRules = map[string]structRule{
+	TagRegisterUsername: {fn: MustValidRegisterSuccess, typ: reflect.TypeOf(model.RegisterRequest{})},
+}
+
+// WithStructRule mendaftarkan struct level validation agar bisa dipakai dari rule file dengan nama name
+// value adalah contoh struct yang divalidasi oleh fn, contoh model.User{}
+func WithStructRule(name string, fn validator.StructLevelFunc, value any) Option {
+	return func(o *options) {
+		o.structRules[name] = structRule{fn: fn, typ: indirectType(reflect.TypeOf(value))}
+	}
+}
+
+// checkRuleAliases mengecek nama alias di rule file sebelum di register, alias tidak boleh menimpa tag validator
+// atau custom validation, karena validator akan memakai alias tersebut tanpa peringatan
+func checkRuleAliases(customTags map[string]bool, aliases map[string]string) (valid map[string]string, issues []ConfigIssue) {
+	valid = map[string]string{}
+	for _, name := range sortedKeys(aliases) {
+		issue := ConfigIssue{Struct: "aliases", Field: name, Tag: name}
+		switch {
+		case name == "" || strings.ContainsAny(name, ",|= \t"):
+			issue.Message = "alias name must not be empty or contain ',', '|', '=' or spaces"
+		case structureTags[name] || builtinTags[name] || customTags[name]:
+			issue.Message = fmt.Sprintf("alias %q would replace a built-in tag or custom validation", name)
+		default:
+			valid[name] = aliases[name]
+			continue
+		}
+		issues = append(issues, issue)
+	}
+
+	return valid, issues
+}
+
+// applyRules mengecek rule file lalu me-register rule field dan struct level ke validator
+// rule hanya di register jika seluruh isi rule file valid
+func (v *Validator) applyRules(o *options, issues []ConfigIssue) error {
+	for _, name := range sortedKeys(o.rules.Aliases) {
+		for _, issue := range v.LintTag(o.rules.Aliases[name], nil) {
+			issue.Struct, issue.Field = "aliases", name
+			issues = append(issues, issue)
+		}
+	}
+
+	types := map[string]reflect.Type{}
+	for _, name := range model.TypeNames() {
+		value, _ := model.New(name)
+		types[name] = indirectType(reflect.TypeOf(value))
+	}
+	for _, value := range o.ruleTypes {
+		typ := indirectType(reflect.TypeOf(value))
+		types[typ.Name()] = typ
+	}
+
+	type registration struct {
+		typ          reflect.Type
+		fields       map[string]string
+		structLevels []validator.StructLevelFunc
+	}
+	var registrations []registration
+
+	v.mapRules = map[string]map[string]any{}
+	for _, name := range sortedKeys(o.rules.Types) {
+		typeRules := o.rules.Types[name]
+		location := "types." + name
+		typ, isStruct := types[name]
+
+		mapRules := map[string]any{}
+		r := registration{typ: typ, fields: map[string]string{}}
+		for _, key := range sortedKeys(typeRules.Fields) {
+			tag := typeRules.Fields[key]
+			mapRules[key] = tag
+
+			var fieldIssues []ConfigIssue
+			if isStruct {
+				field, ok := structField(typ, key)
+				if !ok {
+					issues = append(issues, ConfigIssue{Struct: location + ".fields", Field: key, Tag: tag, Message: fmt.Sprintf("field %q does not exist in %s", key, typ)})
+					continue
+				}
+				r.fields[field.Name] = tag
+				fieldIssues = v.LintTag(tag, func(path string) bool { return hasFieldPath(typ, path) })
+			} else {
+				fieldIssues = v.LintTag(tag, nil)
+				if crossField, ok := v.crossFieldTag(tag); ok {
+					fieldIssues = append(fieldIssues, ConfigIssue{Tag: crossField, Message: "cross-field tags need a struct type, map rules are validated one value at a time"})
+				}
+			}
+			for _, issue := range fieldIssues {
+				issue.Struct, issue.Field = location+".fields", key
+				issues = append(issues, issue)
+			}
+		}
+
+		for _, ruleName := range typeRules.Struct {
+			rule, ok := o.structRules[ruleName]
+			issue := ConfigIssue{Struct: location, Field: "struct", Tag: ruleName}
+			switch {
+			case !ok:
+				issue.Message = fmt.Sprintf("unknown struct rule %q", ruleName)
+			case !isStruct:
+				issue.Message = fmt.Sprintf("struct rules need a struct type, %q is only used for map rules", name)
+			case rule.typ != typ:
+				issue.Message = fmt.Sprintf("struct rule %q validates %s, not %s", ruleName, rule.typ, typ)
+			default:
+				r.structLevels = append(r.structLevels, rule.fn)
+				continue
+			}
+			issues = append(issues, issue)
+		}
+
+		v.mapRules[name] = mapRules
+		if isStruct {
+			registrations = append(registrations, r)
+		}
+	}
+
+	if len(issues) > 0 {
+		return &ConfigError{Issues: issues}
+	}
+
+	for _, r := range registrations {
+		value := reflect.New(r.typ).Elem().Interface()
+		if len(r.fields) > 0 {
+			v.Validate.RegisterStructValidationMapRules(r.fields, value)
+			if v.fieldRules == nil {
+				v.fieldRules = map[reflect.Type]map[string]string{}
+			}
+			v.fieldRules[r.typ] = r.fields
+		}
+		if len(r.structLevels) > 0 {
+			v.Validate.RegisterStructValidation(combineStructLevels(r.structLevels), value)
+		}
+	}
+
+	return nil
+}
+
+// structField mencari field berdasarkan nama json atau nama field go
+func structField(typ reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if field.Name == name || FieldName(field) == name {
+			return field, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+// crossFieldTag mencari tag yang membandingkan dengan field lain, termasuk di dalam alias
+func (v *Validator) crossFieldTag(tag string) (string, bool) {
+	for _, token := range splitTag(tag) {
+		name, _, _ := strings.Cut(token, "=")
+		if expanded, ok := v.aliases[name]; ok {
+			if crossField, ok := v.crossFieldTag(expanded); ok {
+				return crossField, true
+			}
+			continue
+		}
+		if _, ok := fieldReferenceTags[name]; ok {
+			return token, true
+		}
+	}
+
+	return "", false
+}
+
+// combineStructLevels menjalankan beberapa struct level validation secara berurutan
+func combineStructLevels(fns []validator.StructLevelFunc) validator.StructLevelFunc {
+	if len(fns) == 1 {
+		return fns[0]
+	}
+
+	return func(level validator.StructLevel) {
+		for _, fn := range fns {
+			fn(level)
+		}
+	}
+}
+
+// sortedKeys mengembalikan key map yang diurutkan, agar urutan error dan issue selalu sama
+func sortedKeys[T any](values map[string]T) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
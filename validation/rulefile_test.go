@@ -0,0 +1,179 @@
+package validation
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"belajar-go-lang-validation/model"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const testRules = `
+aliases:
+  short_name: required,min=3,max=10
+types:
+  Address:
+    fields:
+      city: short_name
+  RegisterRequest:
+    fields:
+      Phone: omitempty,phone_id
+    struct: [register_username]
+  Product:
+    fields:
+      sku: required,alphanum
+      price: required,gt=0
+`
+
+func TestRulesOnStruct(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	validate, err := New(WithRules(rules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rule file menggantikan tag validate city, country tetap memakai tag struct
+	err = validate.Struct(model.Address{City: "Ab"})
+	tags := map[string]string{}
+	for _, fieldError := range err.(validator.ValidationErrors) {
+		tags[fieldError.Field()] = fieldError.Tag() + "/" + fieldError.ActualTag()
+	}
+	if len(tags) != 2 || tags["city"] != "short_name/min" || tags["country"] != "required/required" {
+		t.Errorf("unexpected errors %v", err)
+	}
+
+	// phone tidak lagi wajib, struct level validation tetap berjalan
+	err = validate.Struct(model.RegisterRequest{Username: "aku", Email: "akuutauf@email.com", Password: "rahasia"})
+	if fieldErrors := validate.FieldErrors(err); len(fieldErrors) != 1 || fieldErrors[0].Tag != TagRegisterUsername {
+		t.Errorf("unexpected errors %v", err)
+	}
+
+	// Default tidak terpengaruh rule file
+	if err := Default().Struct(model.Address{City: "Ab", Country: "Indonesia"}); err != nil {
+		t.Errorf("default validator must keep struct tags, got %v", err)
+	}
+}
+
+func TestRulesOnMap(t *testing.T) {
+	rules, err := ParseRules([]byte(testRules))
+	if err != nil {
+		t.Fatal(err)
+	}
+	validate, err := New(WithRules(rules))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validate.Map("Product", map[string]any{"sku": "ABC123", "price": 1500}); err != nil {
+		t.Errorf("expected valid product, got %v", err)
+	}
+
+	err = validate.Map("Product", map[string]any{"sku": "ABC-123"})
+	fieldErrors := validate.FieldErrors(err, LocaleID)
+	if len(fieldErrors) != 2 {
+		t.Fatalf("unexpected errors %v", err)
+	}
+	if fieldErrors[0].Path != "price" || fieldErrors[0].Tag != "required" || fieldErrors[1].Path != "sku" || fieldErrors[1].Tag != "alphanum" {
+		t.Errorf("unexpected field errors %+v", fieldErrors)
+	}
+	if !strings.Contains(fieldErrors[0].Message, "price") {
+		t.Errorf("message must contain the key, got %q", fieldErrors[0].Message)
+	}
+
+	if err := validate.Map("Order", map[string]any{}); err == nil {
+		t.Error("expected error for unknown type")
+	}
+}
+
+func TestInvalidRuleFile(t *testing.T) {
+	if _, err := ParseRules([]byte("types:\n  User:\n    field:\n      name: required\n")); err == nil {
+		t.Error("expected error for unknown key field")
+	}
+
+	rules, err := ParseRules([]byte(`{
+		"aliases": {"email": "required", "pin6": "pin=enam"},
+		"types": {
+			"User": {"fields": {"nama": "required", "name": "required,short"}, "struct": ["register_username"]},
+			"Product": {"fields": {"price": "gtfield=cost"}, "struct": ["unknown"]}
+		}
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = New(WithRules(rules))
+	var configError *ConfigError
+	if !errors.As(err, &configError) {
+		t.Fatalf("expected config error, got %v", err)
+	}
+
+	expected := []string{
+		`aliases.email: tag "email": alias "email" would replace a built-in tag or custom validation`,
+		`aliases.pin6: tag "pin=enam"`,
+		`types.Product.fields.price: tag "gtfield=cost": cross-field tags need a struct type`,
+		`types.Product.struct: tag "unknown": unknown struct rule "unknown"`,
+		`types.User.fields.name: tag "short": unknown tag "short"`,
+		`types.User.fields.nama: tag "required": field "nama" does not exist in model.User`,
+		`types.User.struct: tag "register_username": struct rule "register_username" validates model.RegisterRequest, not model.User`,
+	}
+	for _, message := range expected {
+		if !strings.Contains(err.Error(), message) {
+			t.Errorf("missing %q in\n%s", message, err)
+		}
+	}
+	if len(configError.Issues) != len(expected) {
+		t.Errorf("expected %d issues, got %d", len(expected), len(configError.Issues))
+	}
+}
+
+func TestWatchRules(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rules.yaml")
+	write := func(content string, modTime time.Time) {
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	write("types:\n  Address:\n    fields:\n      city: required,min=3\n", now)
+	watcher, err := WatchRules(path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := watcher.Validator()
+	if first.Struct(model.Address{City: "Ab", Country: "Indonesia"}) == nil {
+		t.Error("expected min error from rule file")
+	}
+
+	if changed, err := watcher.Reload(); changed || err != nil {
+		t.Errorf("unchanged file must not reload, got %v %v", changed, err)
+	}
+
+	// rule file yang tidak valid tidak mengganti Validator yang sedang dipakai
+	write("types:\n  Address:\n    fields:\n      city: required,short\n", now.Add(time.Second))
+	if _, err := watcher.Reload(); err == nil {
+		t.Error("expected error for invalid rule file")
+	}
+	if watcher.Validator() != first {
+		t.Error("invalid rule file must keep the previous validator")
+	}
+
+	write("types:\n  Address:\n    fields:\n      city: required,min=2\n", now.Add(2*time.Second))
+	if changed, err := watcher.Reload(); !changed || err != nil {
+		t.Fatalf("expected reload, got %v %v", changed, err)
+	}
+	if err := watcher.Validator().Struct(model.Address{City: "Ab", Country: "Indonesia"}); err != nil {
+		t.Errorf("expected reloaded rules, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package validation
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"belajar-go-lang-validation/model"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// nama tag custom yang di register ke validator
+const (
+	TagUsername              = "username"
+	TagPin                   = "pin"
+	TagFieldEqualsIgnoreCase = "field_equals_ignore_case"
+	TagRegisterUsername      = "register_username"
+)
+
+// alias yang di register ke validator, alias bisa berisi lebih dari satu tag
+var aliases = map[string]string{
+	"varchar": "required,max=255",
+}
+
+var regexNumber = regexp.MustCompile("^[0-9]+$")
+
+// MustValidPin memastikan value hanya berisi angka dengan panjang sesuai parameter tag, contoh pin=6
+// parameter yang bukan angka sudah dicek oleh CheckStructs, di sini cukup dianggap tidak valid
+func MustValidPin(field validator.FieldLevel) bool {
+	length, err := strconv.Atoi(field.Param())
+	if err != nil {
+		return false
+	}
+
+	value, ok := field.Field().Interface().(string)
+	if !ok {
+		return false
+	}
+
+	if !regexNumber.MatchString(value) {
+		return false
+	}
+
+	return len(value) == length
+}
+
+// MustEqualsIgnoreCase membandingkan value field dengan field lain (parameter tag) tanpa memperhatikan huruf besar kecil
+// jika keduanya nomor handphone, perbandingan dilakukan setelah di normalisasi, sehingga 0812... sama dengan +62812...
+func MustEqualsIgnoreCase(field validator.FieldLevel) bool {
+	value, _, _, ok := field.GetStructFieldOK2()
+	if !ok {
+		return false
+	}
+
+	data, ok := field.Field().Interface().(string)
+	if !ok || value.Kind() != reflect.String {
+		return false
+	}
+
+	firstValue := strings.ToUpper(data)
+	secondValue := strings.ToUpper(value.String())
+
+	return firstValue == secondValue || samePhone(data, value.String())
+}
+
+// MustValidRegisterSuccess adalah struct level validation untuk model.RegisterRequest
+// username harus sama dengan email atau phone (format phone boleh berbeda), jika tidak akan mengembalikan ReportError dengan tag 'register_username'
+func MustValidRegisterSuccess(level validator.StructLevel) {
+	registerRequest := level.Current().Interface().(model.RegisterRequest)
+
+	if registerRequest.Username == registerRequest.Email || samePhone(registerRequest.Username, registerRequest.Phone) {
+		return
+	}
+
+	level.ReportError(registerRequest.Username, "username", "Username", TagRegisterUsername, "")
+}
@@ -0,0 +1,614 @@
+package validation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SchemaDialect adalah versi JSON Schema yang dihasilkan JSONSchema
+const SchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema adalah dokumen JSON Schema draft 2020-12, hanya berisi keyword yang bisa dihasilkan dari tag validate
+// rule yang tidak memiliki keyword JSON Schema (contoh eqfield atau tag custom) ditulis di Extensions dengan prefix x-
+type Schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Ref                  string             `json:"$ref,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum     *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum     *float64           `json:"exclusiveMaximum,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	AnyOf                []*Schema          `json:"anyOf,omitempty"`
+	Not                  *Schema            `json:"not,omitempty"`
+	Const                any                `json:"const,omitempty"`
+	Examples             []any              `json:"examples,omitempty"`
+	Defs                 map[string]*Schema `json:"$defs,omitempty"`
+	Extensions           map[string]any     `json:"-"`
+}
+
+// MarshalJSON menulis Extensions sebagai keyword biasa di akhir object schema, diurutkan berdasarkan nama
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type plain Schema
+	data, err := json.Marshal((*plain)(s))
+	if err != nil || len(s.Extensions) == 0 {
+		return data, err
+	}
+
+	keys := make([]string, 0, len(s.Extensions))
+	for key := range s.Extensions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	buffer := bytes.NewBuffer(data[:len(data)-1])
+	for i, key := range keys {
+		value, err := json.Marshal(s.Extensions[key])
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 || len(data) > 2 {
+			buffer.WriteByte(',')
+		}
+		name, _ := json.Marshal(key)
+		buffer.Write(name)
+		buffer.WriteByte(':')
+		buffer.Write(value)
+	}
+	buffer.WriteByte('}')
+
+	return buffer.Bytes(), nil
+}
+
+// extend menambahkan keyword extension, contoh x-eqfield
+func (s *Schema) extend(key string, value any) {
+	if s.Extensions == nil {
+		s.Extensions = map[string]any{}
+	}
+	s.Extensions[key] = value
+}
+
+// JSONSchema membuat JSON Schema dari struct, sesuai tag validate yang dijalankan oleh Struct
+// struct di dalamnya ditulis di $defs dan direferensikan dengan $ref, tag yang salah akan mengembalikan *ConfigError
+func (v *Validator) JSONSchema(value any) (*Schema, error) {
+	typ := indirectType(reflect.TypeOf(value))
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("validation: schema expects a struct, got %T", value)
+	}
+
+	builder := newSchemaBuilder(v, "#/$defs/")
+	var root *Schema
+	var err error
+	if typ.Name() == "" {
+		root, err = builder.objectSchema(typ)
+	} else {
+		_, err = builder.structRef(typ)
+		name := SchemaName(typ)
+		root = builder.defs[name]
+		delete(builder.defs, name)
+		// struct yang mereferensikan dirinya sendiri tetap bisa memakai $ref ke $defs
+		if builder.referenced[typ] {
+			builder.defs[name] = &Schema{Ref: "#"}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	root.Schema = SchemaDialect
+	root.Title = typ.Name()
+	if len(builder.defs) > 0 {
+		root.Defs = builder.defs
+	}
+
+	return root, nil
+}
+
+// SchemaComponents membuat schema untuk setiap struct beserta struct di dalamnya, dengan key SchemaName
+// refPrefix adalah prefix $ref ke struct lain, contoh #/components/schemas/ untuk OpenAPI
+func (v *Validator) SchemaComponents(refPrefix string, values ...any) (map[string]*Schema, error) {
+	builder := newSchemaBuilder(v, refPrefix)
+	for _, value := range values {
+		typ := indirectType(reflect.TypeOf(value))
+		if typ == nil || typ.Kind() != reflect.Struct || typ.Name() == "" {
+			return nil, fmt.Errorf("validation: schema components expect a named struct, got %T", value)
+		}
+		if _, err := builder.structRef(typ); err != nil {
+			return nil, err
+		}
+	}
+
+	return builder.defs, nil
+}
+
+// SchemaName adalah key schema struct di $defs dan components, nama tipe beserta nama package, contoh model.User
+// karakter selain huruf, angka, '.', '-' dan '_' (contoh dari tipe generic) diganti dengan '_'
+func SchemaName(typ reflect.Type) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+			return r
+		}
+		return '_'
+	}, indirectType(typ).String())
+}
+
+// schemaBuilder membuat schema untuk satu dokumen, struct yang sudah dibuat disimpan di defs
+// types mencatat tipe untuk setiap key di defs, agar dua tipe dengan SchemaName yang sama tidak saling menimpa
+type schemaBuilder struct {
+	validator  *Validator
+	refPrefix  string
+	defs       map[string]*Schema
+	types      map[string]reflect.Type
+	referenced map[reflect.Type]bool
+}
+
+func newSchemaBuilder(v *Validator, refPrefix string) *schemaBuilder {
+	return &schemaBuilder{
+		validator:  v,
+		refPrefix:  refPrefix,
+		defs:       map[string]*Schema{},
+		types:      map[string]reflect.Type{},
+		referenced: map[reflect.Type]bool{},
+	}
+}
+
+// indirectType mengambil tipe di balik pointer
+func indirectType(typ reflect.Type) reflect.Type {
+	for typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	return typ
+}
+
+// structRef membuat schema struct bernama di defs (jika belum ada) lalu mengembalikan $ref ke schema tersebut
+func (b *schemaBuilder) structRef(typ reflect.Type) (*Schema, error) {
+	name := SchemaName(typ)
+	if existing, ok := b.types[name]; ok {
+		if existing != typ {
+			return nil, fmt.Errorf("validation: schema name %s is used by %s and %s", name, existing.PkgPath(), typ.PkgPath())
+		}
+		b.referenced[typ] = true
+		return &Schema{Ref: b.refPrefix + name}, nil
+	}
+
+	// disimpan sebelum field dibuat, agar struct yang mereferensikan dirinya sendiri tidak diproses berulang
+	b.types[name] = typ
+	b.defs[name] = &Schema{}
+	schema, err := b.objectSchema(typ)
+	if err != nil {
+		return nil, err
+	}
+	*b.defs[name] = *schema
+
+	return &Schema{Ref: b.refPrefix + name}, nil
+}
+
+// objectSchema membuat schema object dari field struct, struct tanpa nama (anonymous) ditulis langsung tanpa $ref
+func (b *schemaBuilder) objectSchema(typ reflect.Type) (*Schema, error) {
+	if err := b.validator.checkType(typ); err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	if err := b.addFields(schema, typ); err != nil {
+		return nil, err
+	}
+	if len(schema.Properties) == 0 {
+		schema.Properties = nil
+	}
+
+	return schema, nil
+}
+
+// addFields menambahkan property dari setiap field, field embedded tanpa nama json digabung seperti encoding/json
+func (b *schemaBuilder) addFields(schema *Schema, typ reflect.Type) error {
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() || strings.SplitN(field.Tag.Get("json"), ",", 2)[0] == "-" {
+			continue
+		}
+
+		if field.Anonymous && fieldName(field) == "" && indirectType(field.Type).Kind() == reflect.Struct {
+			if err := b.addFields(schema, indirectType(field.Type)); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := FieldName(field)
+		property, required, err := b.fieldSchema(typ, field)
+		if err != nil {
+			return err
+		}
+
+		schema.Properties[name] = property
+		if required {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+
+	return nil
+}
+
+// fieldSchema membuat schema satu field, required bernilai true jika field memiliki tag required
+func (b *schemaBuilder) fieldSchema(parent reflect.Type, field reflect.StructField) (*Schema, bool, error) {
+	schema, err := b.typeSchema(field.Type)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rules := field.Tag.Get(ValidateTagKey)
+	if rules == "" || rules == "-" {
+		return schema, false, nil
+	}
+
+	required, err := b.applyTags(schema, parent, field.Type, b.expandAliases(strings.Split(rules, ",")))
+	return schema, required, err
+}
+
+// typeSchema membuat schema dari tipe go tanpa melihat tag, struct menjadi $ref ke defs
+func (b *schemaBuilder) typeSchema(typ reflect.Type) (*Schema, error) {
+	typ = indirectType(typ)
+
+	switch typ.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}, nil
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: "integer"}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: "number"}, nil
+	case reflect.Slice, reflect.Array:
+		items, err := b.typeSchema(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "array", Items: items}, nil
+	case reflect.Map:
+		values, err := b.typeSchema(typ.Elem())
+		if err != nil {
+			return nil, err
+		}
+		return &Schema{Type: "object", AdditionalProperties: values}, nil
+	case reflect.Struct:
+		if typ.Name() == "" {
+			return b.objectSchema(typ)
+		}
+		return b.structRef(typ)
+	}
+
+	return &Schema{}, nil
+}
+
+// expandAliases mengganti alias dengan isi tag-nya, contoh varchar menjadi required dan max=255
+func (b *schemaBuilder) expandAliases(tags []string) []string {
+	expanded := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if aliased, ok := b.validator.aliases[tag]; ok {
+			expanded = append(expanded, b.expandAliases(strings.Split(aliased, ","))...)
+			continue
+		}
+		expanded = append(expanded, tag)
+	}
+
+	return expanded
+}
+
+// applyTags menerapkan tag ke schema, tag setelah dive diterapkan ke items atau additionalProperties
+// required dan omitempty mengikuti cara validator menjalankannya, lihat skipsRequired dan allowEmpty
+func (b *schemaBuilder) applyTags(schema *Schema, parent, typ reflect.Type, tags []string) (bool, error) {
+	pointer := typ.Kind() == reflect.Pointer
+	typ = indirectType(typ)
+	required, omitEmpty := false, false
+
+	for i := 0; i < len(tags); i++ {
+		tag := tags[i]
+		switch tag {
+		case "":
+		case "omitempty":
+			omitEmpty = true
+		case "dive":
+			return required, b.applyDive(schema, parent, typ, tags[i+1:])
+		default:
+			alternatives := strings.Split(tag, "|")
+			if len(alternatives) == 1 {
+				if name, _, _ := strings.Cut(tag, "="); name == "required" {
+					if i == 0 && !pointer && b.skipsRequired(typ) {
+						continue
+					}
+					required = true
+				}
+				b.applyRule(schema, parent, typ, tag)
+				continue
+			}
+
+			for _, alternative := range alternatives {
+				option := &Schema{}
+				b.applyRule(option, parent, typ, alternative)
+				schema.AnyOf = append(schema.AnyOf, option)
+			}
+		}
+	}
+
+	if omitEmpty && !pointer {
+		allowEmpty(schema, typ)
+	}
+
+	return required, nil
+}
+
+// skipsRequired mengecek validator tidak menjalankan tag required pertama di field struct non pointer, kecuali
+// validator dibuat dengan validator.WithRequiredStructEnabled
+func (b *schemaBuilder) skipsRequired(typ reflect.Type) bool {
+	return typ.Kind() == reflect.Struct && !typ.ConvertibleTo(timeType) && !b.validator.requiredStruct
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// allowEmpty membuat schema juga menerima nilai kosong (contoh "" atau 0), karena omitempty melewati tag lain untuk
+// nilai kosong, contoh omitempty,min=3 menerima "" tetapi tidak menerima "ab". Extensions tetap di schema luar
+func allowEmpty(schema *Schema, typ reflect.Type) {
+	var empty any
+	switch typ.Kind() {
+	case reflect.String:
+		empty = ""
+	case reflect.Bool:
+		empty = false
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		empty = 0
+	default:
+		return
+	}
+
+	constrained := *schema
+	constrained.Extensions = nil
+	if reflect.DeepEqual(constrained, Schema{Type: schema.Type}) {
+		return
+	}
+	*schema = Schema{Type: schema.Type, AnyOf: []*Schema{{Const: empty}, &constrained}, Extensions: schema.Extensions}
+}
+
+// applyDive menerapkan tag setelah dive, keys ... endkeys diterapkan ke propertyNames
+func (b *schemaBuilder) applyDive(schema *Schema, parent, typ reflect.Type, tags []string) error {
+	switch typ.Kind() {
+	case reflect.Slice, reflect.Array:
+		_, err := b.applyTags(schema.Items, parent, typ.Elem(), tags)
+		return err
+	case reflect.Map:
+		if len(tags) > 0 && tags[0] == "keys" {
+			end := len(tags)
+			for i, tag := range tags {
+				if tag == "endkeys" {
+					end = i
+					break
+				}
+			}
+
+			schema.PropertyNames = &Schema{Type: "string"}
+			if _, err := b.applyTags(schema.PropertyNames, parent, typ.Key(), tags[1:end]); err != nil {
+				return err
+			}
+			if end == len(tags) {
+				return nil
+			}
+			tags = tags[end+1:]
+		}
+
+		_, err := b.applyTags(schema.AdditionalProperties, parent, typ.Elem(), tags)
+		return err
+	}
+
+	return fmt.Errorf("validation: dive on %s is not supported", typ)
+}
+
+// schemaPatterns adalah pattern untuk tag yang sama dengan regex di validator package
+var schemaPatterns = map[string]string{
+	"number":   "^[0-9]+$",
+	"numeric":  `^[-+]?[0-9]+(?:\.[0-9]+)?$`,
+	"alpha":    "^[a-zA-Z]+$",
+	"alphanum": "^[a-zA-Z0-9]+$",
+	TagNIK:     "^[0-9]{16}$",
+}
+
+// schemaFormats adalah format untuk tag yang tidak bisa ditulis lengkap dengan keyword JSON Schema
+var schemaFormats = map[string]string{
+	"email":        "email",
+	TagNIK:         "nik",
+	TagNPWP:        "npwp",
+	TagPhoneID:     "phone-id",
+	TagPin:         "pin",
+	TagUsername:    "username",
+	TagPassword:    "password",
+	TagBankAccount: "bank-account",
+}
+
+// applyRule menerapkan satu tag ke schema, tag yang tidak memiliki keyword ditulis sebagai extension x-<tag>
+func (b *schemaBuilder) applyRule(schema *Schema, parent, typ reflect.Type, rule string) {
+	tag, param, _ := strings.Cut(rule, "=")
+	kind := typ.Kind()
+
+	if format, ok := schemaFormats[tag]; ok && kind == reflect.String {
+		schema.Format = format
+	}
+	if pattern, ok := schemaPatterns[tag]; ok && kind == reflect.String {
+		schema.Pattern = pattern
+		return
+	}
+
+	switch tag {
+	case "required":
+		switch kind {
+		case reflect.String:
+			if schema.MinLength == nil {
+				schema.MinLength = intPointer(1)
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			schema.Not = &Schema{Const: 0}
+		}
+	case "min", "max", "len", "gt", "gte", "lt", "lte":
+		applySize(schema, kind, tag, param)
+	case "email", "number", "numeric", "alpha", "alphanum":
+	case TagPin:
+		schema.Pattern = "^[0-9]{" + param + "}$"
+	case TagBankCode:
+		for _, bank := range Banks() {
+			schema.Enum = append(schema.Enum, bank.Code)
+		}
+	case TagBankAccount:
+		if bank, ok := LookupBank(param); ok {
+			schema.Pattern = fmt.Sprintf("^[0-9]{%d,%d}$", bank.MinLength, bank.MaxLength)
+		}
+		schema.extend("x-bank-code", param)
+	case TagUsername:
+		b.applyUsername(schema, param)
+	case TagPassword:
+		if policy, ok := b.validator.passwordPolicies[param]; ok {
+			schema.MinLength = positivePointer(policy.MinLength)
+			schema.MaxLength = positivePointer(policy.MaxLength)
+		}
+		schema.extend("x-password-policy", param)
+	default:
+		if _, ok := schemaFormats[tag]; ok {
+			return
+		}
+		if _, ok := fieldReferenceTags[tag]; ok {
+			schema.extend("x-"+tag, fieldReferenceName(parent, param))
+			return
+		}
+
+		// tag lain tetap ditulis agar client tahu ada rule yang hanya dicek di server
+		rules, _ := schema.Extensions["x-validate"].([]string)
+		schema.extend("x-validate", append(rules, rule))
+	}
+}
+
+// applyUsername menulis aturan username profile yang bisa ditulis dengan keyword JSON Schema
+func (b *schemaBuilder) applyUsername(schema *Schema, param string) {
+	if param == "" {
+		param = DefaultUsernameProfile
+	}
+	schema.extend("x-username-profile", param)
+
+	policy, ok := b.validator.usernameProfiles[param]
+	if !ok {
+		return
+	}
+
+	schema.MinLength = positivePointer(policy.MinLength)
+	schema.MaxLength = positivePointer(policy.MaxLength)
+	if policy.Charset != nil {
+		schema.Pattern = policy.Charset.String()
+	}
+	switch policy.Case {
+	case CaseUpper:
+		schema.extend("x-username-case", "upper")
+	case CaseLower:
+		schema.extend("x-username-case", "lower")
+	}
+}
+
+// fieldReferenceName mengubah parameter nama field go menjadi nama json, contoh Password menjadi password
+func fieldReferenceName(parent reflect.Type, param string) string {
+	if parent == nil {
+		return param
+	}
+
+	names := strings.Fields(param)
+	for i, name := range names {
+		if field, ok := parent.FieldByName(name); ok {
+			if wire := fieldName(field); wire != "" {
+				names[i] = wire
+			}
+		}
+	}
+
+	return strings.Join(names, " ")
+}
+
+// applySize menerapkan tag min, max, len, gt, gte, lt dan lte sesuai tipe field
+func applySize(schema *Schema, kind reflect.Kind, tag, param string) {
+	number, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return
+	}
+	size := int(number)
+
+	var minimum, maximum **int
+	switch kind {
+	case reflect.String:
+		minimum, maximum = &schema.MinLength, &schema.MaxLength
+	case reflect.Slice, reflect.Array:
+		minimum, maximum = &schema.MinItems, &schema.MaxItems
+	case reflect.Map:
+		minimum, maximum = &schema.MinProperties, &schema.MaxProperties
+	default:
+		switch tag {
+		case "min", "gte":
+			schema.Minimum = &number
+		case "max", "lte":
+			schema.Maximum = &number
+		case "len":
+			schema.Const = number
+		case "gt":
+			schema.ExclusiveMinimum = &number
+		case "lt":
+			schema.ExclusiveMaximum = &number
+		}
+		return
+	}
+
+	switch tag {
+	case "min", "gte":
+		*minimum = intPointer(size)
+	case "max", "lte":
+		*maximum = intPointer(size)
+	case "len":
+		*minimum, *maximum = intPointer(size), intPointer(size)
+	case "gt":
+		*minimum = intPointer(size + 1)
+	case "lt":
+		*maximum = intPointer(size - 1)
+	}
+}
+
+func intPointer(value int) *int {
+	return &value
+}
+
+// positivePointer mengembalikan nil untuk nilai 0, karena 0 di policy berarti aturan tidak dicek
+func positivePointer(value int) *int {
+	if value <= 0 {
+		return nil
+	}
+
+	return &value
+}
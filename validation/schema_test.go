@@ -0,0 +1,169 @@
+package validation
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestJSONSchemaUser(t *testing.T) {
+	schema, err := Default().JSONSchema(model.User{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if schema.Schema != SchemaDialect || schema.Title != "User" || schema.Type != "object" {
+		t.Errorf("unexpected root: %+v", schema)
+	}
+	if !reflect.DeepEqual(schema.Required, []string{"id", "name", "address", "hobbies"}) {
+		t.Errorf("unexpected required: %v", schema.Required)
+	}
+
+	address := schema.Properties["address"]
+	if address.Type != "array" || address.Items.Ref != "#/$defs/model.Address" || schema.Defs["model.Address"] == nil {
+		t.Errorf("unexpected address: %+v", address)
+	}
+
+	hobbies := schema.Properties["hobbies"].Items
+	if *hobbies.MinLength != 3 {
+		t.Errorf("expected hobby minLength 3, got %v", *hobbies.MinLength)
+	}
+
+	wallets := schema.Properties["wallets"]
+	if len(wallets.PropertyNames.Enum) != len(Banks()) || *wallets.AdditionalProperties.ExclusiveMinimum != 1000 {
+		t.Errorf("unexpected wallets: %+v", wallets)
+	}
+
+	schools := schema.Properties["schools"]
+	if *schools.PropertyNames.MinLength != 2 || schools.AdditionalProperties.Ref != "#/$defs/model.School" {
+		t.Errorf("unexpected schools: %+v", schools)
+	}
+}
+
+func TestJSONSchemaCustomTags(t *testing.T) {
+	type Account struct {
+		Username        string `json:"username" validate:"required,username=handle"`
+		Pin             string `json:"pin" validate:"required,pin=6"`
+		Description     string `json:"description" validate:"varchar"`
+		Password        string `json:"password" validate:"required,password=strong"`
+		ConfirmPassword string `json:"confirm_password" validate:"required,eqfield=Password"`
+		Contact         string `json:"contact" validate:"email|phone_id"`
+	}
+
+	schema, err := Default().JSONSchema(Account{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	body, err := json.Marshal(schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var document struct {
+		Required   []string                  `json:"required"`
+		Properties map[string]map[string]any `json:"properties"`
+	}
+	if err := json.Unmarshal(body, &document); err != nil {
+		t.Fatal(err)
+	}
+
+	username := document.Properties["username"]
+	if username["format"] != "username" || username["pattern"] != "^[a-z0-9_.]+$" || username["x-username-profile"] != "handle" {
+		t.Errorf("unexpected username: %v", username)
+	}
+	if pin := document.Properties["pin"]; pin["pattern"] != "^[0-9]{6}$" || pin["format"] != "pin" {
+		t.Errorf("unexpected pin: %v", pin)
+	}
+	if description := document.Properties["description"]; description["maxLength"] != 255.0 {
+		t.Errorf("unexpected description: %v", description)
+	}
+	if password := document.Properties["password"]; password["minLength"] != 8.0 || password["x-password-policy"] != "strong" {
+		t.Errorf("unexpected password: %v", password)
+	}
+	if confirm := document.Properties["confirm_password"]; confirm["x-eqfield"] != "password" {
+		t.Errorf("unexpected confirm_password: %v", confirm)
+	}
+	if contact := document.Properties["contact"]; len(contact["anyOf"].([]any)) != 2 {
+		t.Errorf("unexpected contact: %v", contact)
+	}
+	if !reflect.DeepEqual(document.Required, []string{"username", "pin", "description", "password", "confirm_password"}) {
+		t.Errorf("unexpected required: %v", document.Required)
+	}
+}
+
+func TestJSONSchemaNames(t *testing.T) {
+	// Address dengan nama yang sama dari package lain tidak menimpa model.Address
+	type Address struct {
+		Street string `json:"street" validate:"required"`
+	}
+	type Order struct {
+		Billing  Address       `json:"billing"`
+		Shipping model.Address `json:"shipping"`
+		Contact  struct {
+			Phone string `json:"phone" validate:"required,phone_id"`
+		} `json:"contact"`
+	}
+
+	schema, err := Default().JSONSchema(Order{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if schema.Properties["billing"].Ref != "#/$defs/validation.Address" || schema.Properties["shipping"].Ref != "#/$defs/model.Address" {
+		t.Errorf("unexpected refs: %v, %v", schema.Properties["billing"], schema.Properties["shipping"])
+	}
+	if schema.Defs["validation.Address"].Properties["street"] == nil || schema.Defs["model.Address"].Properties["city"] == nil {
+		t.Errorf("unexpected defs: %v", schema.Defs)
+	}
+
+	// struct anonymous ditulis langsung karena tidak memiliki nama untuk $defs
+	contact := schema.Properties["contact"]
+	if contact.Ref != "" || contact.Type != "object" || contact.Properties["phone"].Format != "phone-id" || len(schema.Defs) != 2 {
+		t.Errorf("unexpected contact: %+v, defs %v", contact, schema.Defs)
+	}
+}
+
+func TestJSONSchemaValidatorSemantics(t *testing.T) {
+	type Profile struct {
+		Nickname string         `json:"nickname" validate:"omitempty,min=3"`
+		Age      int            `json:"age" validate:"omitempty,gte=17"`
+		Bio      *string        `json:"bio" validate:"omitempty,min=3"`
+		Address  model.Address  `json:"address" validate:"required"`
+		Billing  *model.Address `json:"billing" validate:"required"`
+	}
+
+	schema, err := Default().JSONSchema(Profile{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// omitempty melewati min untuk "" dan 0, pointer nil tidak memiliki representasi kosong selain null
+	nickname := schema.Properties["nickname"]
+	if len(nickname.AnyOf) != 2 || nickname.AnyOf[0].Const != "" || *nickname.AnyOf[1].MinLength != 3 || nickname.MinLength != nil {
+		t.Errorf("unexpected nickname: %+v", nickname)
+	}
+	if age := schema.Properties["age"]; len(age.AnyOf) != 2 || age.AnyOf[0].Const != 0 || *age.AnyOf[1].Minimum != 17 {
+		t.Errorf("unexpected age: %+v", age)
+	}
+	if bio := schema.Properties["bio"]; bio.AnyOf != nil || *bio.MinLength != 3 {
+		t.Errorf("unexpected bio: %+v", bio)
+	}
+
+	// required di field struct non pointer tidak dicek validator tanpa WithRequiredStructEnabled
+	if !reflect.DeepEqual(schema.Required, []string{"billing"}) {
+		t.Errorf("unexpected required: %v", schema.Required)
+	}
+
+	validate, err := New(WithValidatorOptions(validator.WithRequiredStructEnabled()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema, err := validate.JSONSchema(Profile{}); err != nil || !reflect.DeepEqual(schema.Required, []string{"address", "billing"}) {
+		t.Errorf("expected address to be required with WithRequiredStructEnabled, got %v (%v)", schema, err)
+	}
+}
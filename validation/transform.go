@@ -0,0 +1,227 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// ModTagKey adalah nama key struct tag untuk modifier yang dijalankan oleh Transform, contoh mod:"trim,lower"
+const ModTagKey = "mod"
+
+// Modifier mengubah isi field string sebelum divalidasi
+type Modifier func(value string) string
+
+// modifier bawaan project, dijalankan berurutan sesuai isi tag mod
+var modifiers = map[string]Modifier{
+	"trim":     strings.TrimSpace,
+	"lower":    strings.ToLower,
+	"upper":    strings.ToUpper,
+	"collapse": collapseSpaces,
+	"digits":   onlyDigits,
+	"nfc":      norm.NFC.String,
+	"phone":    normalizePhone,
+}
+
+// collapseSpaces mengganti deretan whitespace dengan satu spasi dan menghapus whitespace di awal dan akhir
+func collapseSpaces(value string) string {
+	return strings.Join(strings.Fields(value), " ")
+}
+
+// onlyDigits menghapus seluruh karakter selain angka, contoh 0812-3456-7890 menjadi 081234567890
+func onlyDigits(value string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, value)
+}
+
+// normalizePhone mengubah nomor handphone menjadi format E.164, nomor yang tidak valid tidak diubah agar error phone_id tetap muncul
+func normalizePhone(value string) string {
+	phone, err := NormalizePhone(value)
+	if err != nil {
+		return value
+	}
+
+	return phone
+}
+
+// WithModifier menambahkan modifier baru atau mengganti modifier bawaan project
+func WithModifier(name string, fn Modifier) Option {
+	return func(o *options) {
+		o.modifiers[name] = fn
+	}
+}
+
+// modField adalah field struct beserta modifier dari tag mod
+type modField struct {
+	index     int
+	modifiers []Modifier
+}
+
+// Transform menjalankan modifier dari tag mod ke seluruh field string, termasuk di dalam struct, slice, array dan map
+// s harus pointer ke struct, field diubah langsung (in place). Tag mod pada slice atau map string berlaku untuk setiap element
+// jika tag mod tidak valid akan mengembalikan *ConfigError tanpa mengubah apapun
+func (v *Validator) Transform(s any) error {
+	value := reflect.ValueOf(s)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return errors.New("validation: Transform needs a non-nil pointer")
+	}
+
+	if err := v.checkType(value.Type()); err != nil {
+		return err
+	}
+
+	v.transform(value.Elem(), nil)
+	return nil
+}
+
+// TransformStruct menjalankan Transform lalu Struct, digunakan untuk request yang perlu dirapikan sebelum divalidasi
+func (v *Validator) TransformStruct(s any) error {
+	return v.TransformStructCtx(context.Background(), s)
+}
+
+// TransformStructCtx sama dengan TransformStruct dengan context
+func (v *Validator) TransformStructCtx(ctx context.Context, s any) error {
+	if err := v.Transform(s); err != nil {
+		return err
+	}
+
+	return v.StructCtx(ctx, s)
+}
+
+// TransformPatchCtx menjalankan Transform lalu PatchCtx, sama dengan TransformStructCtx untuk request PATCH
+// s harus pointer ke struct hasil DecodePartial
+func (v *Validator) TransformPatchCtx(ctx context.Context, s any, presence Presence) error {
+	if err := v.Transform(s); err != nil {
+		return err
+	}
+
+	return v.PatchCtx(ctx, s, presence)
+}
+
+// transform menelusuri value, modifiers adalah modifier dari tag mod field yang sedang ditelusuri
+// struct di dalamnya memakai tag mod masing-masing field, bukan modifier dari parent
+func (v *Validator) transform(value reflect.Value, modifiers []Modifier) {
+	switch value.Kind() {
+	case reflect.Pointer:
+		if !value.IsNil() {
+			v.transform(value.Elem(), modifiers)
+		}
+	case reflect.Interface:
+		if value.IsNil() || !value.CanSet() {
+			return
+		}
+		// isi interface tidak addressable, diubah melalui salinan lalu di set kembali
+		element := reflect.New(value.Elem().Type()).Elem()
+		element.Set(value.Elem())
+		v.transform(element, modifiers)
+		value.Set(element)
+	case reflect.String:
+		if len(modifiers) == 0 || !value.CanSet() {
+			return
+		}
+		text := value.String()
+		for _, modifier := range modifiers {
+			text = modifier(text)
+		}
+		value.SetString(text)
+	case reflect.Struct:
+		for _, field := range v.modFields(value.Type()) {
+			v.transform(value.Field(field.index), field.modifiers)
+		}
+	case reflect.Slice, reflect.Array:
+		if !needsTransform(value.Type().Elem(), modifiers) {
+			return
+		}
+		for i := range value.Len() {
+			v.transform(value.Index(i), modifiers)
+		}
+	case reflect.Map:
+		if !needsTransform(value.Type().Elem(), modifiers) {
+			return
+		}
+		// element map tidak addressable, diubah melalui salinan lalu di set kembali ke key yang sama
+		element := reflect.New(value.Type().Elem()).Elem()
+		iterator := value.MapRange()
+		for iterator.Next() {
+			element.Set(iterator.Value())
+			v.transform(element, modifiers)
+			value.SetMapIndex(iterator.Key(), element)
+		}
+	}
+}
+
+// needsTransform mengecek element collection perlu ditelusuri, slice angka misalnya tidak perlu
+func needsTransform(typ reflect.Type, modifiers []Modifier) bool {
+	switch elementType(typ).Kind() {
+	case reflect.String:
+		return len(modifiers) > 0
+	case reflect.Struct, reflect.Interface:
+		return true
+	default:
+		return false
+	}
+}
+
+// modFields mengembalikan field struct yang perlu ditelusuri Transform, hasilnya di cache per tipe
+func (v *Validator) modFields(typ reflect.Type) []modField {
+	if cached, ok := v.modCache.Load(typ); ok {
+		return cached.([]modField)
+	}
+
+	var fields []modField
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		var fieldModifiers []Modifier
+		for _, name := range splitTag(field.Tag.Get(ModTagKey)) {
+			if modifier, ok := v.modifiers[name]; ok {
+				fieldModifiers = append(fieldModifiers, modifier)
+			}
+		}
+		if len(fieldModifiers) > 0 || needsTransform(field.Type, nil) {
+			fields = append(fields, modField{index: i, modifiers: fieldModifiers})
+		}
+	}
+	v.modCache.Store(typ, fields)
+
+	return fields
+}
+
+// LintMod mengecek satu tag mod, seluruh modifier harus bawaan project atau terdaftar dengan WithModifier
+// tipe field tidak dicek, CheckStructs juga memastikan field dengan tag mod berisi string
+func (v *Validator) LintMod(tag string) []ConfigIssue {
+	var issues []ConfigIssue
+	for _, name := range splitTag(tag) {
+		if _, ok := v.modifiers[name]; !ok {
+			issues = append(issues, ConfigIssue{Tag: name, Message: fmt.Sprintf("unknown modifier %q", name)})
+		}
+	}
+
+	return issues
+}
+
+// lintMods mengecek tag mod satu field, modifier harus terdaftar dan field harus berisi string
+func (v *Validator) lintMods(field reflect.StructField) []ConfigIssue {
+	tag, ok := field.Tag.Lookup(ModTagKey)
+	if !ok {
+		return nil
+	}
+
+	issues := v.LintMod(tag)
+	if kind := elementType(field.Type).Kind(); kind != reflect.String && kind != reflect.Interface {
+		issues = append(issues, ConfigIssue{Tag: tag, Message: fmt.Sprintf("mod tag needs a string field, got %s", field.Type)})
+	}
+
+	return issues
+}
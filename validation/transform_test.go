@@ -0,0 +1,101 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+)
+
+type contact struct {
+	Name    string            `mod:"collapse,nfc"`
+	Email   string            `mod:"trim,lower"`
+	Code    *string           `mod:"trim,upper"`
+	Phone   string            `mod:"phone"`
+	Pin     string            `mod:"digits"`
+	Tags    []string          `mod:"trim"`
+	Notes   map[string]string `mod:"trim"`
+	Extra   any               `mod:"trim"`
+	Ignored string
+}
+
+func TestTransform(t *testing.T) {
+	code := " jkt "
+	value := struct {
+		Contact  contact
+		Contacts []*contact
+		Keyed    map[string]contact
+	}{
+		Contact: contact{
+			// e + combining acute (NFD) menjadi é (NFC)
+			Name:    "  Jose\u0301   Rizal ",
+			Email:   " Aku@Email.COM ",
+			Code:    &code,
+			Phone:   "0812-3456-7890",
+			Pin:     "12 34-56",
+			Tags:    []string{" a ", "b "},
+			Notes:   map[string]string{"x": " catatan "},
+			Extra:   " lain ",
+			Ignored: " tetap ",
+		},
+		Contacts: []*contact{{Email: " A@B.COM "}, nil},
+		Keyed:    map[string]contact{"SMP": {Name: " SMP   Negeri "}},
+	}
+
+	if err := Default().Transform(&value); err != nil {
+		t.Fatal(err)
+	}
+
+	got := value.Contact
+	if got.Name != "Jos\u00e9 Rizal" || got.Email != "aku@email.com" || *got.Code != "JKT" || got.Phone != "+6281234567890" || got.Pin != "123456" {
+		t.Errorf("unexpected strings %+v", got)
+	}
+	if got.Tags[0] != "a" || got.Tags[1] != "b" || got.Notes["x"] != "catatan" || got.Extra != "lain" || got.Ignored != " tetap " {
+		t.Errorf("unexpected collections %+v", got)
+	}
+	if value.Contacts[0].Email != "a@b.com" || value.Keyed["SMP"].Name != "SMP Negeri" {
+		t.Errorf("nested structs must be transformed, got %+v %+v", value.Contacts[0], value.Keyed)
+	}
+}
+
+func TestTransformStruct(t *testing.T) {
+	validate := Default()
+
+	request := model.RegisterRequest{Username: " Aku@Email.com", Email: "AKU@email.com ", Phone: " 081234567890 ", Password: "rahasia"}
+	if err := validate.Struct(request); err == nil {
+		t.Fatal("expected errors before transform")
+	}
+	if err := validate.TransformStruct(&request); err != nil {
+		t.Errorf("expected valid request after transform, got %v", err)
+	}
+
+	if err := validate.Transform(request); err == nil {
+		t.Error("expected error for non pointer")
+	}
+}
+
+func TestTransformConfigError(t *testing.T) {
+	type invalid struct {
+		Name string `mod:"trim,shout"`
+		Age  int    `mod:"trim"`
+	}
+
+	value := invalid{Name: " a "}
+	err := Default().Transform(&value)
+	var configError *ConfigError
+	if !errors.As(err, &configError) || len(configError.Issues) != 2 {
+		t.Fatalf("expected 2 config issues, got %v", err)
+	}
+	if !strings.Contains(err.Error(), `unknown modifier "shout"`) || value.Name != " a " {
+		t.Errorf("unexpected result %v %q", err, value.Name)
+	}
+
+	validate, err := New(WithModifier("shout", strings.ToUpper))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := validate.CheckStructs(invalid{}); err == nil || strings.Contains(err.Error(), "shout") {
+		t.Errorf("expected only the int field issue, got %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/locales/id"
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+	en_translations "github.com/go-playground/validator/v10/translations/en"
+	id_translations "github.com/go-playground/validator/v10/translations/id"
+	"golang.org/x/text/language"
+)
+
+// locale yang didukung untuk pesan error
+const (
+	LocaleID = "id"
+	LocaleEN = "en"
+)
+
+// pesan error untuk tag custom project, {0} adalah nama field dan {1} adalah parameter tag
+var customTranslations = map[string]map[string]string{
+	LocaleID: {
+		TagUsername:              "{0} tidak memenuhi aturan username",
+		TagUsernameType:          "{0} harus berupa teks untuk divalidasi sebagai username",
+		TagUsernameLength:        "{0} harus memiliki panjang minimal {1} karakter",
+		TagUsernameMax:           "{0} harus memiliki panjang maksimal {1} karakter",
+		TagUsernameUpper:         "{0} harus ditulis dengan huruf besar semua",
+		TagUsernameLower:         "{0} harus ditulis dengan huruf kecil semua",
+		TagUsernameCharset:       "{0} mengandung karakter yang tidak diperbolehkan",
+		TagUsernameLeadingDigit:  "{0} tidak boleh diawali angka",
+		TagUsernameReserved:      "{0} sudah dipakai oleh sistem",
+		TagRegisterUsername:      "{0} harus sama dengan email atau phone",
+		TagPin:                   "{0} harus berupa PIN angka sepanjang {1} digit",
+		TagFieldEqualsIgnoreCase: "{0} harus sama dengan {1} (tidak membedakan huruf besar kecil)",
+		TagNIK:                   "{0} harus berupa NIK yang valid",
+		TagNPWP:                  "{0} harus berupa NPWP 15 digit (xx.xxx.xxx.x-xxx.xxx) atau 16 digit yang valid",
+		TagPhoneID:               "{0} harus berupa nomor handphone indonesia yang valid, contoh 081234567890",
+		TagBankCode:              "{0} harus berupa kode bank yang terdaftar",
+		TagBankAccount:           "{0} harus berupa nomor rekening {1} yang valid",
+		TagPassword:              "{0} tidak memenuhi kebijakan password {1}",
+		TagPasswordLength:        "{0} harus memiliki panjang minimal {1} karakter",
+		TagPasswordMax:           "{0} harus memiliki panjang maksimal {1} karakter",
+		TagPasswordUpper:         "{0} harus mengandung huruf besar",
+		TagPasswordLower:         "{0} harus mengandung huruf kecil",
+		TagPasswordDigit:         "{0} harus mengandung angka",
+		TagPasswordSymbol:        "{0} harus mengandung simbol",
+		TagPasswordRepeat:        "{0} tidak boleh memiliki lebih dari {1} karakter sama berturut-turut",
+		TagPasswordBanned:        "{0} tidak boleh mengandung isi {1}",
+		TagPasswordCommon:        "{0} terlalu umum dan mudah ditebak",
+		TagType:                  "{0} harus berupa {1}",
+		TagAvailable:             "{0} sudah digunakan",
+		TagParse:                 "{0} harus berupa {1} yang valid",
+	},
+	LocaleEN: {
+		TagUsername:              "{0} does not satisfy the username rules",
+		TagUsernameType:          "{0} must be text to be validated as a username",
+		TagUsernameLength:        "{0} must be at least {1} characters long",
+		TagUsernameMax:           "{0} must be at most {1} characters long",
+		TagUsernameUpper:         "{0} must be all upper case",
+		TagUsernameLower:         "{0} must be all lower case",
+		TagUsernameCharset:       "{0} contains characters that are not allowed",
+		TagUsernameLeadingDigit:  "{0} must not start with a digit",
+		TagUsernameReserved:      "{0} is reserved",
+		TagRegisterUsername:      "{0} must be equal to email or phone",
+		TagPin:                   "{0} must be a numeric PIN of {1} digits",
+		TagFieldEqualsIgnoreCase: "{0} must be equal to {1} (case insensitive)",
+		TagNIK:                   "{0} must be a valid Indonesian identity number (NIK)",
+		TagNPWP:                  "{0} must be a valid 15 digit (xx.xxx.xxx.x-xxx.xxx) or 16 digit tax number (NPWP)",
+		TagPhoneID:               "{0} must be a valid Indonesian mobile number, e.g. 081234567890",
+		TagBankCode:              "{0} must be a registered bank code",
+		TagBankAccount:           "{0} must be a valid {1} account number",
+		TagPassword:              "{0} does not satisfy the {1} password policy",
+		TagPasswordLength:        "{0} must be at least {1} characters long",
+		TagPasswordMax:           "{0} must be at most {1} characters long",
+		TagPasswordUpper:         "{0} must contain an upper case letter",
+		TagPasswordLower:         "{0} must contain a lower case letter",
+		TagPasswordDigit:         "{0} must contain a digit",
+		TagPasswordSymbol:        "{0} must contain a symbol",
+		TagPasswordRepeat:        "{0} must not repeat the same character more than {1} times in a row",
+		TagPasswordBanned:        "{0} must not contain the {1}",
+		TagPasswordCommon:        "{0} is too common and easy to guess",
+		TagType:                  "{0} must be an {1}",
+		TagAvailable:             "{0} is already taken",
+		TagParse:                 "{0} must be a valid {1}",
+	},
+}
+
+// WithDefaultLocale mengganti locale yang digunakan jika locale request tidak didukung, defaultnya LocaleEN
+func WithDefaultLocale(locale string) Option {
+	return func(o *options) {
+		o.defaultLocale = locale
+	}
+}
+
+// registerTranslations mendaftarkan translasi bawaan validator package dan translasi tag custom
+// untuk bahasa indonesia dan inggris
+func registerTranslations(validate *validator.Validate, defaultLocale string) (*ut.UniversalTranslator, error) {
+	english := en.New()
+	indonesia := id.New()
+
+	fallback := english
+	if defaultLocale == LocaleID {
+		fallback = indonesia
+	}
+	universal := ut.New(fallback, english, indonesia)
+
+	defaults := map[string]func(*validator.Validate, ut.Translator) error{
+		LocaleEN: en_translations.RegisterDefaultTranslations,
+		LocaleID: id_translations.RegisterDefaultTranslations,
+	}
+	for locale, register := range defaults {
+		trans, _ := universal.GetTranslator(locale)
+		if err := register(validate, trans); err != nil {
+			return nil, err
+		}
+	}
+
+	for locale, messages := range customTranslations {
+		trans, _ := universal.GetTranslator(locale)
+		for tag, message := range messages {
+			if err := registerTranslation(validate, trans, tag, message); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return universal, nil
+}
+
+// registerTranslation mendaftarkan satu pesan untuk satu tag, override jika tag sudah punya translasi
+func registerTranslation(validate *validator.Validate, trans ut.Translator, tag, message string) error {
+	return validate.RegisterTranslation(tag, trans, func(trans ut.Translator) error {
+		return trans.Add(tag, message, true)
+	}, translateField)
+}
+
+// translateField mengisi {0} dengan nama field dan {1} dengan parameter tag
+func translateField(trans ut.Translator, fieldError validator.FieldError) string {
+	message, err := trans.T(fieldError.Tag(), fieldError.Field(), fieldError.Param())
+	if err != nil {
+		return fieldError.Error()
+	}
+
+	return message
+}
+
+// Translator mengembalikan translator untuk locale pertama yang didukung, contoh "id", "en-US" atau "id_ID"
+// jika tidak ada locale yang didukung, akan mengembalikan translator default
+func (v *Validator) Translator(locales ...string) ut.Translator {
+	candidates := make([]string, 0, len(locales))
+	for _, locale := range locales {
+		candidates = append(candidates, baseLocale(locale))
+	}
+
+	trans, _ := v.universal.FindTranslator(candidates...)
+	return trans
+}
+
+// Translate mengubah error hasil validasi menjadi pesan yang bisa dibaca user, dengan key namespace field
+// jika satu field memiliki beberapa error sub-rule, pesannya digabung dengan "; "
+// error selain validator.ValidationErrors akan dikembalikan nil
+func (v *Validator) Translate(err error, locales ...string) validator.ValidationErrorsTranslations {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
+		return nil
+	}
+
+	// tidak menggunakan validationErrors.Translate, karena hanya bisa menerima FieldError bawaan validator package
+	trans := v.Translator(locales...)
+	translations := validator.ValidationErrorsTranslations{}
+	for _, fieldError := range validationErrors {
+		message := fieldError.Translate(trans)
+		if previous, ok := translations[fieldError.Namespace()]; ok {
+			message = previous + "; " + message
+		}
+		translations[fieldError.Namespace()] = message
+	}
+
+	return translations
+}
+
+// LocalesFromAcceptLanguage mengambil daftar locale dari header Accept-Language sesuai urutan prioritas
+func LocalesFromAcceptLanguage(header string) []string {
+	tags, _, err := language.ParseAcceptLanguage(header)
+	if err != nil {
+		return nil
+	}
+
+	locales := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		locales = append(locales, tag.String())
+	}
+
+	return locales
+}
+
+// baseLocale mengambil bahasa utama dari locale, contoh "id-ID" menjadi "id"
+func baseLocale(locale string) string {
+	locale = strings.ToLower(strings.TrimSpace(locale))
+	if i := strings.IndexAny(locale, "-_"); i >= 0 {
+		locale = locale[:i]
+	}
+
+	return locale
+}
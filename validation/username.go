@@ -0,0 +1,162 @@
+package validation
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// tag untuk setiap sub-rule username, setiap sub-rule yang gagal akan menjadi satu error tersendiri
+const (
+	TagUsernameType         = "username_type"
+	TagUsernameLength       = "username_length"
+	TagUsernameMax          = "username_max"
+	TagUsernameUpper        = "username_upper"
+	TagUsernameLower        = "username_lower"
+	TagUsernameCharset      = "username_charset"
+	TagUsernameLeadingDigit = "username_leading_digit"
+	TagUsernameReserved     = "username_reserved"
+)
+
+// DefaultUsernameProfile adalah profile yang dipakai jika tag username tidak memiliki parameter
+const DefaultUsernameProfile = "default"
+
+// UsernameCase adalah aturan huruf besar kecil username
+type UsernameCase int
+
+const (
+	CaseAny UsernameCase = iota
+	CaseUpper
+	CaseLower
+)
+
+// UsernamePolicy adalah aturan username, nilai 0, nil atau false berarti aturan tersebut tidak dicek
+type UsernamePolicy struct {
+	MinLength int
+	MaxLength int
+	Case      UsernameCase
+	// Charset adalah karakter yang boleh digunakan, contoh regexp.MustCompile(`^[a-z0-9_.]+$`)
+	Charset        *regexp.Regexp
+	NoLeadingDigit bool
+	// Reserved adalah username yang tidak boleh digunakan, dicek tanpa memperhatikan huruf besar kecil
+	Reserved []string
+}
+
+// profile bawaan, default sama dengan aturan lama MustValidUsername (huruf besar semua dan minimal 5 karakter)
+var usernameProfiles = map[string]UsernamePolicy{
+	DefaultUsernameProfile: {
+		MinLength: 5,
+		Case:      CaseUpper,
+	},
+	"handle": {
+		MinLength:      3,
+		MaxLength:      30,
+		Case:           CaseLower,
+		Charset:        regexp.MustCompile(`^[a-z0-9_.]+$`),
+		NoLeadingDigit: true,
+		Reserved:       []string{"admin", "administrator", "root", "system", "support", "help", "api", "www", "null", "undefined", "me"},
+	},
+}
+
+// WithUsernameProfile menambahkan profile baru atau mengganti profile bawaan dengan nama yang sama
+func WithUsernameProfile(name string, policy UsernamePolicy) Option {
+	return func(o *options) {
+		o.usernameProfiles[name] = policy
+	}
+}
+
+// Check mengecek username dan mengembalikan seluruh sub-rule yang gagal
+func (p UsernamePolicy) Check(username string) []RuleViolation {
+	var violations []RuleViolation
+
+	length := len([]rune(username))
+	if length < p.MinLength {
+		violations = append(violations, RuleViolation{Tag: TagUsernameLength, Param: strconv.Itoa(p.MinLength)})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, RuleViolation{Tag: TagUsernameMax, Param: strconv.Itoa(p.MaxLength)})
+	}
+
+	switch {
+	case p.Case == CaseUpper && username != strings.ToUpper(username):
+		violations = append(violations, RuleViolation{Tag: TagUsernameUpper})
+	case p.Case == CaseLower && username != strings.ToLower(username):
+		violations = append(violations, RuleViolation{Tag: TagUsernameLower})
+	}
+
+	if p.Charset != nil && username != "" && !p.Charset.MatchString(username) {
+		violations = append(violations, RuleViolation{Tag: TagUsernameCharset})
+	}
+
+	if p.NoLeadingDigit && username != "" && unicode.IsDigit([]rune(username)[0]) {
+		violations = append(violations, RuleViolation{Tag: TagUsernameLeadingDigit})
+	}
+
+	for _, reserved := range p.Reserved {
+		if strings.EqualFold(username, reserved) {
+			violations = append(violations, RuleViolation{Tag: TagUsernameReserved})
+			break
+		}
+	}
+
+	return violations
+}
+
+// usernameProfile mengambil profile dari parameter tag, parameter kosong berarti DefaultUsernameProfile
+func usernameProfile(profiles map[string]UsernamePolicy, param string) (UsernamePolicy, bool) {
+	if param == "" {
+		param = DefaultUsernameProfile
+	}
+
+	policy, ok := profiles[param]
+	return policy, ok
+}
+
+// MustValidUsername memastikan username sesuai profile default, field yang bukan string dianggap tidak valid
+func MustValidUsername(field validator.FieldLevel) bool {
+	return validUsername(usernameProfiles)(field)
+}
+
+// validUsername membuat fungsi validasi username dengan daftar profile tertentu, contoh username=handle
+func validUsername(profiles map[string]UsernamePolicy) validator.Func {
+	return func(field validator.FieldLevel) bool {
+		if field.Field().Kind() != reflect.String {
+			return false
+		}
+
+		policy, ok := usernameProfile(profiles, field.Param())
+		if !ok {
+			return false
+		}
+
+		return len(policy.Check(field.Field().String())) == 0
+	}
+}
+
+// usernameErrors memecah error tag username menjadi satu error untuk setiap sub-rule yang gagal
+func (v *Validator) usernameErrors(fieldError validator.FieldError) []validator.FieldError {
+	if fieldError.Kind() != reflect.String {
+		return []validator.FieldError{&ruleError{FieldError: fieldError, tag: TagUsernameType}}
+	}
+
+	policy, ok := usernameProfile(v.usernameProfiles, fieldError.Param())
+	if !ok {
+		return []validator.FieldError{fieldError}
+	}
+
+	violations := policy.Check(reflect.ValueOf(fieldError.Value()).String())
+	if len(violations) == 0 {
+		return []validator.FieldError{fieldError}
+	}
+
+	result := make([]validator.FieldError, 0, len(violations))
+	for _, violation := range violations {
+		result = append(result, &ruleError{FieldError: fieldError, tag: violation.Tag, param: violation.Param})
+	}
+
+	return result
+}
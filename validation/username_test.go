@@ -0,0 +1,57 @@
+package validation
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestUsernameProfiles(t *testing.T) {
+	type Account struct {
+		Legacy string `json:"legacy" validate:"username"`
+		Handle string `json:"handle" validate:"username=handle"`
+		Number int    `json:"number" validate:"username"`
+	}
+
+	if err := Default().Struct(Account{Legacy: "AKUUTAUF", Handle: "akuutauf.dev"}); tags(err)[0] != TagUsernameType {
+		t.Errorf("non string field must fail, got %v", err)
+	}
+
+	got := tags(Default().Struct(Account{Legacy: "akuu", Handle: "9Admin"}))
+	expected := []string{TagUsernameLength, TagUsernameUpper, TagUsernameLower, TagUsernameCharset, TagUsernameLeadingDigit, TagUsernameType}
+	if len(got) != len(expected) {
+		t.Fatalf("expected %v, got %v", expected, got)
+	}
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("expected %v, got %v", expected, got)
+		}
+	}
+
+	if err := Default().Var("admin", "username=handle"); len(tags(err)) != 1 || tags(err)[0] != TagUsernameReserved {
+		t.Errorf("expected reserved error, got %v", err)
+	}
+}
+
+func TestUsernameCustomProfile(t *testing.T) {
+	validate, err := New(WithUsernameProfile("employee", UsernamePolicy{
+		MinLength: 6,
+		MaxLength: 6,
+		Charset:   regexp.MustCompile(`^EMP[0-9]{3}$`),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := validate.Var("EMP001", "username=employee"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	type Employee struct {
+		Code string `json:"code" validate:"username=employee"`
+	}
+
+	messages := validate.Translate(validate.Struct(Employee{Code: "EMP0001"}), LocaleEN)
+	if messages["Employee.code"] != "code must be at most 6 characters long; code contains characters that are not allowed" {
+		t.Errorf("unexpected messages %q", messages)
+	}
+}
@@ -0,0 +1,361 @@
+// package validation menyediakan object validate yang sudah berisi seluruh custom validation,
+// alias dan struct level validation project, sehingga setiap service cukup import satu package ini
+//
+// validator package di desain thread safe dan sebaiknya digunakan sebagai singleton,
+// karena validator melakukan cache informasi rules dan tags dari setiap struct
+package validation
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"time"
+
+	"belajar-go-lang-validation/model"
+
+	ut "github.com/go-playground/universal-translator"
+	"github.com/go-playground/validator/v10"
+)
+
+// Validator membungkus validator.Validate, semua method validator.Validate (Struct, Var, dll) tetap bisa digunakan
+type Validator struct {
+	*validator.Validate
+
+	universal        *ut.UniversalTranslator
+	passwordPolicies map[string]PasswordPolicy
+	usernameProfiles map[string]UsernamePolicy
+	aliases          map[string]string
+	paramCheckers    map[string]paramChecker
+	// validations adalah custom validation yang di register, dipakai juga oleh kode hasil validategen
+	validations map[string]validator.Func
+	// mapRules adalah rule dari rule file per nama tipe, dipakai oleh Map
+	mapRules map[string]map[string]any
+	// fieldRules adalah tag dari rule file per tipe struct dan nama field go, menggantikan tag validate
+	fieldRules map[reflect.Type]map[string]string
+	// splitFields adalah cache field collection yang bisa divalidasi per element, dipakai oleh StructParallel
+	splitFields sync.Map
+	// modifiers adalah modifier yang bisa dipakai di tag mod
+	modifiers map[string]Modifier
+	// modCache adalah cache field yang perlu ditelusuri Transform per tipe struct
+	modCache sync.Map
+	// repository digunakan oleh tag available, nil jika WithRepository tidak dipakai
+	repository    Repository
+	lookupTimeout time.Duration
+	// checked adalah cache hasil pengecekan konfigurasi tag per tipe struct
+	checked sync.Map
+	// checkedTags adalah cache hasil pengecekan tag per isi tag yang dipakai Var
+	checkedTags sync.Map
+	// requiredStruct bernilai true jika validator.WithRequiredStructEnabled dipakai, dipakai oleh JSONSchema
+	requiredStruct bool
+	// customTags adalah nama seluruh custom validation yang di register, dipakai oleh KnownTag
+	customTags map[string]bool
+}
+
+// Option digunakan untuk mengubah konfigurasi saat membuat Validator baru
+type Option func(*options)
+
+type options struct {
+	validatorOptions []validator.Option
+	aliases          map[string]string
+	defaultLocale    string
+	regions          *Regions
+	passwordPolicies map[string]PasswordPolicy
+	usernameProfiles map[string]UsernamePolicy
+	structs          []any
+	rules            *RuleFile
+	ruleTypes        []any
+	structRules      map[string]structRule
+	modifiers        map[string]Modifier
+	repository       Repository
+	lookupTimeout    time.Duration
+}
+
+// WithValidatorOptions meneruskan option bawaan validator package, contoh validator.WithRequiredStructEnabled()
+func WithValidatorOptions(opts ...validator.Option) Option {
+	return func(o *options) {
+		o.validatorOptions = append(o.validatorOptions, opts...)
+	}
+}
+
+// WithAlias menambahkan alias baru atau mengganti alias bawaan project
+func WithAlias(alias, tags string) Option {
+	return func(o *options) {
+		o.aliases[alias] = tags
+	}
+}
+
+// New membuat Validator baru dengan seluruh rule project yang sudah di register
+// gunakan Default() jika tidak butuh konfigurasi khusus, agar cache validator bisa dipakai bersama
+func New(opts ...Option) (*Validator, error) {
+	o := &options{
+		aliases:          map[string]string{},
+		defaultLocale:    LocaleEN,
+		regions:          defaultRegions,
+		passwordPolicies: map[string]PasswordPolicy{},
+		usernameProfiles: map[string]UsernamePolicy{},
+		structRules:      map[string]structRule{},
+		modifiers:        map[string]Modifier{},
+	}
+	for alias, tags := range aliases {
+		o.aliases[alias] = tags
+	}
+	for name, policy := range passwordPolicies {
+		o.passwordPolicies[name] = policy
+	}
+	for name, policy := range usernameProfiles {
+		o.usernameProfiles[name] = policy
+	}
+	for name, modifier := range modifiers {
+		o.modifiers[name] = modifier
+	}
+	for name, rule := range structRules {
+		o.structRules[name] = rule
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	validate := validator.New(o.validatorOptions...)
+	validate.RegisterTagNameFunc(fieldName)
+
+	// tanpa validator.WithRequiredStructEnabled, tag required di field struct non pointer tidak dicek
+	var requiredProbe struct {
+		Value struct{} `validate:"required"`
+	}
+	requiredStruct := validate.Struct(requiredProbe) != nil
+
+	validations := customValidations(o)
+	customTags := map[string]bool{TagAvailable: true}
+	for tag, fn := range validations {
+		if err := validate.RegisterValidation(tag, fn); err != nil {
+			return nil, err
+		}
+		customTags[tag] = true
+	}
+
+	// alias dari rule file dicek lebih dulu, alias yang salah tidak di register agar RegisterAlias tidak panic
+	var ruleIssues []ConfigIssue
+	if o.rules != nil {
+		var ruleAliases map[string]string
+		ruleAliases, ruleIssues = checkRuleAliases(customTags, o.rules.Aliases)
+		for alias, tags := range ruleAliases {
+			o.aliases[alias] = tags
+		}
+	}
+
+	for alias, tags := range o.aliases {
+		validate.RegisterAlias(alias, tags)
+	}
+
+	validate.RegisterStructValidation(MustValidRegisterSuccess, model.RegisterRequest{})
+
+	universal, err := registerTranslations(validate, o.defaultLocale)
+	if err != nil {
+		return nil, err
+	}
+
+	v := &Validator{
+		Validate:         validate,
+		universal:        universal,
+		passwordPolicies: o.passwordPolicies,
+		usernameProfiles: o.usernameProfiles,
+		aliases:          o.aliases,
+		paramCheckers:    paramCheckers(o),
+		validations:      validations,
+		customTags:       customTags,
+		requiredStruct:   requiredStruct,
+		modifiers:        o.modifiers,
+		repository:       o.repository,
+		lookupTimeout:    o.lookupTimeout,
+	}
+
+	// tag available tetap di register tanpa repository, agar LintTag bisa memberi pesan yang jelas
+	if err := v.RegisterValidationCtx(TagAvailable, v.mustBeAvailable); err != nil {
+		return nil, err
+	}
+
+	if o.rules != nil {
+		if err := v.applyRules(o, ruleIssues); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := v.CheckStructs(o.structs...); err != nil {
+		return nil, err
+	}
+
+	return v, nil
+}
+
+// customValidations mengembalikan seluruh custom validation project dengan konfigurasi dari option
+func customValidations(o *options) map[string]validator.Func {
+	return map[string]validator.Func{
+		TagUsername:              validUsername(o.usernameProfiles),
+		TagPin:                   MustValidPin,
+		TagFieldEqualsIgnoreCase: MustEqualsIgnoreCase,
+		TagNIK:                   validNIK(o.regions),
+		TagNPWP:                  validNPWP(o.regions),
+		TagPhoneID:               MustValidPhoneID,
+		TagBankCode:              MustValidBankCode,
+		TagBankAccount:           MustValidBankAccount,
+		TagPassword:              validPassword(o.passwordPolicies),
+	}
+}
+
+// RegisterValidation sama dengan validator.Validate.RegisterValidation, tag dicatat agar dikenal oleh KnownTag
+// dan hasil pengecekan tag yang sudah di cache dihapus. Sama dengan validator package, tidak thread safe
+func (v *Validator) RegisterValidation(tag string, fn validator.Func, callValidationEvenIfNull ...bool) error {
+	if err := v.Validate.RegisterValidation(tag, fn, callValidationEvenIfNull...); err != nil {
+		return err
+	}
+
+	v.validations[tag] = fn
+	v.registered(tag)
+	return nil
+}
+
+// RegisterValidationCtx sama dengan RegisterValidation untuk validator.FuncCtx
+func (v *Validator) RegisterValidationCtx(tag string, fn validator.FuncCtx, callValidationEvenIfNull ...bool) error {
+	if err := v.Validate.RegisterValidationCtx(tag, fn, callValidationEvenIfNull...); err != nil {
+		return err
+	}
+
+	delete(v.validations, tag)
+	v.registered(tag)
+	return nil
+}
+
+// RegisterAlias sama dengan validator.Validate.RegisterAlias, alias juga bisa diambil dengan Alias
+func (v *Validator) RegisterAlias(alias, tags string) {
+	v.Validate.RegisterAlias(alias, tags)
+	v.aliases[alias] = tags
+	v.resetChecks()
+}
+
+// registered mencatat custom validation baru lalu menghapus cache yang bergantung pada tag yang dikenal
+func (v *Validator) registered(tag string) {
+	v.customTags[tag] = true
+	v.resetChecks()
+}
+
+// resetChecks menghapus hasil pengecekan tag per tipe dan per tag, karena tag yang sebelumnya tidak dikenal
+// mungkin sudah di register
+func (v *Validator) resetChecks() {
+	v.checked.Clear()
+	v.checkedTags.Clear()
+	v.splitFields.Clear()
+}
+
+// Alias mengembalikan isi tag dari alias yang di register, contoh varchar menjadi required,max=255
+func (v *Validator) Alias(name string) (string, bool) {
+	tags, ok := v.aliases[name]
+	return tags, ok
+}
+
+// Struct sama dengan validator.Validate.Struct, ditambah pemecahan error password dan username per sub-rule
+func (v *Validator) Struct(s any) error {
+	return v.StructCtx(context.Background(), s)
+}
+
+// StructCtx sama dengan validator.Validate.StructCtx, ditambah pemecahan error password dan username per sub-rule
+// konfigurasi tag dicek sekali per tipe, jika ada tag yang salah akan mengembalikan *ConfigError tanpa melakukan validasi
+// jumlah error bisa dibatasi dengan WithErrorLimit, element collection di field struct paling luar berhenti divalidasi
+// setelah batasnya terlampaui
+func (v *Validator) StructCtx(ctx context.Context, s any) error {
+	if errorLimitFrom(ctx).enabled() {
+		return v.structSplit(ctx, s, 1, 1)
+	}
+
+	return v.structCtx(ctx, s)
+}
+
+// structCtx memvalidasi seluruh struct sekaligus dengan validator, kecuali field di excluded (lihat StructExceptCtx)
+func (v *Validator) structCtx(ctx context.Context, s any, excluded ...string) error {
+	if err := v.checkType(reflect.TypeOf(s)); err != nil {
+		return err
+	}
+
+	ctx, lookups := v.startLookups(ctx)
+	var err error
+	if len(excluded) > 0 {
+		err = v.Validate.StructExceptCtx(ctx, s, excluded...)
+	} else {
+		err = v.Validate.StructCtx(ctx, s)
+	}
+	err = v.expandErrors(err, reflect.ValueOf(s))
+
+	return finishLookups(lookups, errorLimitFrom(ctx).apply(err))
+}
+
+// Var sama dengan validator.Validate.Var, ditambah pemecahan error password dan username per sub-rule
+func (v *Validator) Var(field any, tag string) error {
+	return v.VarCtx(context.Background(), field, tag)
+}
+
+// VarCtx sama dengan validator.Validate.VarCtx, ditambah pemecahan error password dan username per sub-rule
+// jika parameter tag custom tidak valid akan mengembalikan *ConfigError tanpa melakukan validasi, dicek sekali per tag
+func (v *Validator) VarCtx(ctx context.Context, field any, tag string) error {
+	if err := v.checkTag(tag); err != nil {
+		return err
+	}
+
+	ctx, lookups := v.startLookups(ctx)
+	err := v.expandErrors(v.Validate.VarCtx(ctx, field, tag), reflect.Value{})
+
+	return finishLookups(lookups, errorLimitFrom(ctx).apply(err))
+}
+
+// ExpandErrors memecah error password dan username per sub-rule, sama seperti yang dilakukan Struct
+// digunakan untuk error dari method Validate hasil validategen, root adalah struct yang divalidasi
+func (v *Validator) ExpandErrors(err error, root any) error {
+	return v.expandErrors(err, reflect.ValueOf(root))
+}
+
+// expandErrors memecah error yang memiliki sub-rule (contoh tag password dan username) menjadi beberapa error
+// lokasi setiap error ditelusuri dari root, sehingga path dan pointer tidak dibuat dengan membaca namespace
+func (v *Validator) expandErrors(err error, root reflect.Value) error {
+	validationErrors, ok := err.(validator.ValidationErrors)
+	if !ok {
+		return err
+	}
+
+	expanded := make(validator.ValidationErrors, 0, len(validationErrors))
+	for _, fieldError := range validationErrors {
+		location, located := locate(root, fieldError.StructNamespace())
+
+		fieldErrors := []validator.FieldError{fieldError}
+		switch fieldError.Tag() {
+		case TagPassword:
+			fieldErrors = v.passwordErrors(fieldError, location.parent)
+		case TagUsername:
+			fieldErrors = v.usernameErrors(fieldError)
+		}
+
+		for _, fieldError := range fieldErrors {
+			if located {
+				fieldError = &locatedError{FieldError: fieldError, location: location}
+			}
+			expanded = append(expanded, fieldError)
+		}
+	}
+
+	return expanded
+}
+
+var (
+	defaultOnce      sync.Once
+	defaultValidator *Validator
+)
+
+// Default mengembalikan Validator singleton, dibuat sekali saja saat pertama kali dipanggil
+func Default() *Validator {
+	defaultOnce.Do(func() {
+		v, err := New()
+		if err != nil {
+			panic(err)
+		}
+		defaultValidator = v
+	})
+
+	return defaultValidator
+}
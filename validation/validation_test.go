@@ -0,0 +1,115 @@
+package validation
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"belajar-go-lang-validation/model"
+
+	"github.com/go-playground/validator/v10"
+)
+
+func TestDefaultIsSingleton(t *testing.T) {
+	var wg sync.WaitGroup
+	results := make([]*Validator, 10)
+
+	// memanggil Default dari banyak goroutine sekaligus, hasilnya harus object yang sama
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i] = Default()
+		}(i)
+	}
+	wg.Wait()
+
+	for _, v := range results {
+		if v != results[0] {
+			t.Fatal("Default must return the same validator")
+		}
+	}
+}
+
+func TestCustomTagsRegistered(t *testing.T) {
+	validate := Default()
+
+	type LoginRequest struct {
+		Username string `validate:"required,username"`
+		Password string `validate:"required,pin=6"`
+	}
+
+	if err := validate.Struct(LoginRequest{Username: "AKUUTAUF", Password: "123456"}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	err := validate.Struct(LoginRequest{Username: "akuutauf", Password: "12345"})
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) || len(validationErrors) != 2 {
+		t.Fatalf("expected 2 validation errors, got %v", err)
+	}
+}
+
+func TestAliasAndOption(t *testing.T) {
+	validate, err := New(WithAlias("kode", "required,len=3"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type Seller struct {
+		Id   string `validate:"kode"`
+		Name string `validate:"varchar"`
+	}
+
+	if err := validate.Struct(Seller{Id: "ABC", Name: "Taufik"}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if err := validate.Struct(Seller{Id: "AB"}); err == nil {
+		t.Error("expected error from alias")
+	}
+}
+
+func TestRegisterStructLevel(t *testing.T) {
+	request := model.RegisterRequest{
+		Username: "akuutauf@email.com",
+		Email:    "taufik@email.com",
+		Phone:    "081234567890",
+		Password: "rahasia",
+	}
+
+	err := Default().Struct(request)
+
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) || validationErrors[0].Tag() != TagRegisterUsername {
+		t.Fatalf("expected username error, got %v", err)
+	}
+
+	request.Username = request.Email
+	if err := Default().Struct(request); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestTranslate(t *testing.T) {
+	type LoginRequest struct {
+		Username string `validate:"required,email"`
+		Password string `validate:"required,pin=6"`
+		Nickname string `validate:"username"`
+	}
+
+	err := Default().Struct(LoginRequest{Username: "taufik", Password: "123", Nickname: "taufik"})
+
+	indonesia := Default().Translate(err, "id-ID")
+	if indonesia["LoginRequest.Username"] != "Username harus berupa alamat email yang valid" {
+		t.Errorf("unexpected message: %q", indonesia["LoginRequest.Username"])
+	}
+	if indonesia["LoginRequest.Password"] != "Password harus berupa PIN angka sepanjang 6 digit" {
+		t.Errorf("unexpected message: %q", indonesia["LoginRequest.Password"])
+	}
+
+	english := Default().Translate(err, LocalesFromAcceptLanguage("fr-CH, en;q=0.9")...)
+	if english["LoginRequest.Nickname"] != "Nickname must be all upper case" {
+		t.Errorf("unexpected message: %q", english["LoginRequest.Nickname"])
+	}
+}
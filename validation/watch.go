@@ -0,0 +1,87 @@
+package validation
+
+import (
+	"context"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// RuleWatcher membaca ulang rule file saat isinya berubah dan membuat Validator baru
+// validator tidak aman di register ulang saat sedang dipakai, sehingga setiap reload membuat Validator baru
+// dan request yang sedang berjalan tetap memakai Validator lama sampai selesai
+type RuleWatcher struct {
+	path    string
+	types   []any
+	opts    []Option
+	current atomic.Pointer[Validator]
+
+	mutex   sync.Mutex
+	modTime time.Time
+	size    int64
+}
+
+// WatchRules membaca rule file di path dan membuat Validator pertama, opts dan types diteruskan ke New setiap reload
+// jika rule file tidak valid akan mengembalikan error, sama seperti New
+func WatchRules(path string, types []any, opts ...Option) (*RuleWatcher, error) {
+	w := &RuleWatcher{path: path, types: types, opts: opts}
+	if _, err := w.Reload(); err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// Validator mengembalikan Validator dari rule file terakhir yang valid
+func (w *RuleWatcher) Validator() *Validator {
+	return w.current.Load()
+}
+
+// Reload membaca ulang rule file jika waktu modifikasi atau ukurannya berubah, mengembalikan true jika Validator diganti
+// jika rule file baru tidak valid, Validator lama tetap dipakai dan error dikembalikan
+func (w *RuleWatcher) Reload() (bool, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	info, err := os.Stat(w.path)
+	if err != nil {
+		return false, err
+	}
+	if w.current.Load() != nil && info.ModTime().Equal(w.modTime) && info.Size() == w.size {
+		return false, nil
+	}
+	// dicatat sebelum dibaca, agar file yang tidak valid tidak dilaporkan berulang kali sampai diubah lagi
+	w.modTime, w.size = info.ModTime(), info.Size()
+
+	rules, err := LoadRules(w.path)
+	if err != nil {
+		return false, err
+	}
+
+	opts := append(w.opts[:len(w.opts):len(w.opts)], WithRules(rules, w.types...))
+	v, err := New(opts...)
+	if err != nil {
+		return false, err
+	}
+	w.current.Store(v)
+
+	return true, nil
+}
+
+// Run mengecek rule file setiap interval sampai ctx selesai, error reload dikirim ke onError (boleh nil)
+func (w *RuleWatcher) Run(ctx context.Context, interval time.Duration, onError func(error)) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := w.Reload(); err != nil && onError != nil {
+				onError(err)
+			}
+		}
+	}
+}